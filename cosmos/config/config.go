@@ -127,6 +127,11 @@ func readConfigFromAppOptsParser(parser AppOptionsParser) (*Config, error) {
 		return nil, err
 	}
 
+	if conf.Polar.MinerBuildTimeout, err =
+		parser.GetTimeDuration(flags.MinerBuildTimeout); err != nil {
+		return nil, err
+	}
+
 	// Polar Chain settings
 	if conf.Polar.Chain.ChainID, err =
 		parser.GetBigInt(flags.ChainID); err != nil {
@@ -324,6 +329,100 @@ func readConfigFromAppOptsParser(parser AppOptionsParser) (*Config, error) {
 		return nil, err
 	}
 
+	// BlobPool
+	if conf.Polar.BlobPool.Datadir, err =
+		parser.GetString(flags.BlobPoolDatadir); err != nil {
+		return nil, err
+	}
+
+	if conf.Polar.BlobPool.Datadir == "" {
+		conf.Polar.BlobPool.Datadir, err =
+			parser.GetString(sdkflags.FlagHome)
+		if err != nil {
+			return nil, err
+		}
+		conf.Polar.BlobPool.Datadir += "/data/blobpool"
+	}
+
+	if conf.Polar.BlobPool.Datacap, err =
+		parser.GetUint64(flags.BlobPoolDatacap); err != nil {
+		return nil, err
+	}
+
+	if conf.Polar.BlobPool.PriceBump, err =
+		parser.GetUint64(flags.BlobPoolPriceBump); err != nil {
+		return nil, err
+	}
+
+	// Historical
+	if conf.Polar.Historical.Backend, err =
+		parser.GetString(flags.HistoricalBackend); err != nil {
+		return nil, err
+	}
+
+	if conf.Polar.Historical.Datadir, err =
+		parser.GetString(flags.HistoricalDatadir); err != nil {
+		return nil, err
+	}
+
+	if conf.Polar.Historical.Datadir == "" {
+		conf.Polar.Historical.Datadir, err =
+			parser.GetString(sdkflags.FlagHome)
+		if err != nil {
+			return nil, err
+		}
+		conf.Polar.Historical.Datadir += "/data"
+	}
+
+	if conf.Polar.Historical.Retention.Mode, err =
+		parser.GetString(flags.HistoricalRetention); err != nil {
+		return nil, err
+	}
+
+	if conf.Polar.Historical.Retention.Blocks, err =
+		parser.GetUint64(flags.HistoricalRetentionBlocks); err != nil {
+		return nil, err
+	}
+
+	if err = conf.Polar.Historical.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Bloombits
+	if conf.Polar.BloomBits.Datadir, err =
+		parser.GetString(flags.BloomBitsDatadir); err != nil {
+		return nil, err
+	}
+
+	if conf.Polar.BloomBits.Datadir == "" {
+		conf.Polar.BloomBits.Datadir, err =
+			parser.GetString(sdkflags.FlagHome)
+		if err != nil {
+			return nil, err
+		}
+		conf.Polar.BloomBits.Datadir += "/data"
+	}
+
+	// Genesis
+	if conf.Polar.Genesis.AllocDir, err =
+		parser.GetString(flags.GenesisAllocDir); err != nil {
+		return nil, err
+	}
+
+	if conf.Polar.Genesis.AllocDir == "" {
+		conf.Polar.Genesis.AllocDir, err =
+			parser.GetString(sdkflags.FlagHome)
+		if err != nil {
+			return nil, err
+		}
+		conf.Polar.Genesis.AllocDir += "/config/evm-alloc"
+	}
+
+	if conf.Polar.Genesis.AllocChunkSize, err =
+		parser.GetUint64(flags.GenesisAllocChunkSize); err != nil {
+		return nil, err
+	}
+
 	// Node settings
 	if conf.Node.Name, err =
 		parser.GetString(flags.Name); err != nil {
@@ -464,6 +563,10 @@ func readConfigFromAppOptsParser(parser AppOptionsParser) (*Config, error) {
 		parser.GetString(flags.DBEngine); err != nil {
 		return nil, err
 	}
+	if conf.Node.HealthAddr, err =
+		parser.GetString(flags.HealthAddr); err != nil {
+		return nil, err
+	}
 
 	// Node.HTTPTimeouts settings
 	if conf.Node.HTTPTimeouts.ReadTimeout, err =
@@ -34,6 +34,7 @@ const (
 	MinerGasPrice          = "polaris.polar.miner.gas-price"
 	MinerRecommit          = "polaris.polar.miner.recommit"
 	MinerNewPayloadTimeout = "polaris.polar.miner.new-payload-timeout"
+	MinerBuildTimeout      = "polaris.polar.miner.build-timeout"
 
 	// GPO.
 	Blocks           = "polaris.polar.gpo.blocks"
@@ -82,6 +83,7 @@ const (
 	IgnorePrice           = "polaris.node.http-timeouts.ignore-price"
 	GraphqlVirtualHosts   = "polaris.node.graphql-virtual-hosts"
 	IpcPath               = "polaris.node.ipc-path"
+	HealthAddr            = "polaris.node.health-addr"
 
 	// Legacy TxPool.
 	Locals       = "polaris.polar.legacy-tx-pool.locals"
@@ -96,6 +98,24 @@ const (
 	GlobalQueue  = "polaris.polar.legacy-tx-pool.global-queue"
 	Lifetime     = "polaris.polar.legacy-tx-pool.lifetime"
 
+	// Blob TxPool.
+	BlobPoolDatadir   = "polaris.polar.blob-pool.datadir"
+	BlobPoolDatacap   = "polaris.polar.blob-pool.datacap"
+	BlobPoolPriceBump = "polaris.polar.blob-pool.price-bump"
+
+	// Historical data.
+	HistoricalBackend         = "polaris.polar.historical.backend"
+	HistoricalDatadir         = "polaris.polar.historical.datadir"
+	HistoricalRetention       = "polaris.polar.historical.retention"
+	HistoricalRetentionBlocks = "polaris.polar.historical.retention-blocks"
+
+	// Bloombits.
+	BloomBitsDatadir = "polaris.polar.bloom-bits.datadir"
+
+	// Genesis.
+	GenesisAllocDir       = "polaris.polar.genesis.alloc-dir"
+	GenesisAllocChunkSize = "polaris.polar.genesis.alloc-chunk-size"
+
 	// Chain Config.
 	ChainID                       = "polaris.polar.chain.chain-id"
 	HomesteadBlock                = "polaris.polar.chain.homestead-block"
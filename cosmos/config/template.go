@@ -40,7 +40,8 @@ rpc-evm-timeout = "{{ .Polaris.Polar.RPCEVMTimeout }}"
 rpc-tx-fee-cap = "{{ .Polaris.Polar.RPCTxFeeCap }}"
 
 
-# Chain config
+# Chain config, which must match the chain config stored in the x/evm state. A fork that is set
+# to "<nil>" defers to the on-chain value.
 [polaris.polar.chain] 
 chain-id = "{{ .Polaris.Polar.Chain.ChainID }}"
 
@@ -134,6 +135,11 @@ recommit = "{{.Polaris.Polar.Miner.Recommit }}"
 # Timeout for creating a new payload
 new-payload-timeout = "{{.Polaris.Polar.Miner.NewPayloadTimeout }}"
 
+# Time budget for building a payload when proposing. The full payload is proposed once it is built,
+# or the best payload built so far at the end of the budget. It should stay below CometBFT's
+# timeout_propose and above new-payload-timeout.
+build-timeout = "{{.Polaris.Polar.MinerBuildTimeout }}"
+
 
 # Gas price oracle settings for Polaris
 [polaris.polar.gpo]
@@ -194,6 +200,49 @@ global-queue = "{{ .Polaris.Polar.LegacyTxPool.GlobalQueue }}"
 # Maximum amount of time non-executable transaction are queued
 lifetime = "{{ .Polaris.Polar.LegacyTxPool.Lifetime }}"
 
+[polaris.polar.blob-pool]
+
+# Data directory to store blob transactions in
+datadir = "{{ .Polaris.Polar.BlobPool.Datadir }}"
+
+# Soft-cap of the blob transaction storage, in bytes
+datacap = "{{ .Polaris.Polar.BlobPool.Datacap }}"
+
+# Minimum price bump percentage to replace an already existing blob transaction (nonce)
+price-bump = "{{ .Polaris.Polar.BlobPool.PriceBump }}"
+
+[polaris.polar.historical]
+
+# Database backend of a node-local store for historical blocks, receipts and tx lookups
+# (goleveldb, pebbledb), leave empty to keep historical data in the app state
+backend = "{{ .Polaris.Polar.Historical.Backend }}"
+
+# Data directory of the node-local historical data store
+datadir = "{{ .Polaris.Polar.Historical.Datadir }}"
+
+# Retention policy of historical data: archive keeps everything, recent keeps the last
+# retention-blocks blocks, receipts keeps the receipts and tx lookups of every block but only
+# the bodies of the last retention-blocks blocks. Pruning requires a node-local backend, as
+# historical data in the app state is part of consensus. Older blocks of an existing history are
+# pruned over a number of blocks
+retention = "{{ .Polaris.Polar.Historical.Retention.Mode }}"
+
+# Number of most recent blocks that are fully retained (recent and receipts policies)
+retention-blocks = "{{ .Polaris.Polar.Historical.Retention.Blocks }}"
+
+[polaris.polar.bloom-bits]
+
+# Data directory of the bloombits index that speeds up log filtering
+datadir = "{{ .Polaris.Polar.BloomBits.Datadir }}"
+
+[polaris.polar.genesis]
+
+# Directory that the chunks of the evm genesis alloc are exported to and imported from
+alloc-dir = "{{ .Polaris.Polar.Genesis.AllocDir }}"
+
+# Number of accounts in each chunk of an exported evm genesis alloc, 0 exports the alloc inline
+# in the genesis file
+alloc-chunk-size = "{{ .Polaris.Polar.Genesis.AllocChunkSize }}"
 
 # Node-specific settings
 [polaris.node]
@@ -296,6 +345,10 @@ jwt-secret = "{{ .Polaris.Node.JWTSecret }}"
 # Database engine for the node
 db-engine = "{{ .Polaris.Node.DBEngine }}"
 
+# Address of the health and readiness endpoints, served from startup on a listener of their own
+# rather than the JSON-RPC server. Empty disables them.
+health-addr = "{{ .Polaris.Node.HealthAddr }}"
+
 
 # HTTP timeout settings for the node
 [polaris.node.http-timeouts]
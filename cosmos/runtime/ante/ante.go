@@ -21,7 +21,7 @@
 package ante
 
 import (
-	"errors"
+	"context"
 
 	storetypes "cosmossdk.io/store/types"
 
@@ -35,16 +35,33 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
+// EnvelopeVerifier verifies that the payload envelope executed in FinalizeBlock is the one of
+// the proposal that was accepted for the block.
+type EnvelopeVerifier interface {
+	VerifyEnvelope(ctx sdk.Context) error
+}
+
+// ParamsProvider provides the x/evm params, which partition the block space that the payload
+// envelope may use, at the given context.
+type ParamsProvider interface {
+	GetParams(ctx context.Context) evmtypes.Params
+}
+
 // Provider is a struct that holds the ante handlers for EVM and Cosmos.
 type Provider struct {
-	evmAnteHandler    sdk.AnteHandler // Ante handler for EVM transactions
-	cosmosAnteHandler sdk.AnteHandler // Ante handler for Cosmos transactions
+	evmAnteHandler    sdk.AnteHandler  // Ante handler for EVM transactions
+	cosmosAnteHandler sdk.AnteHandler  // Ante handler for Cosmos transactions
+	mempool           *txpool.Mempool  // Mempool used to evict invalid Cosmos transactions
+	envelopes         EnvelopeVerifier // Verifier of the payload envelopes of blocks
+	params            ParamsProvider   // Provider of the params that limit the envelope gas
 }
 
-// NewAnteHandler creates a new Provider with a mempool and Cosmos ante handler.
-// It sets up the EVM ante handler with the necessary decorators.
+// NewAnteHandler creates a new Provider with a mempool, a verifier of payload envelopes, the
+// x/evm params and Cosmos ante handler. It sets up the EVM ante handler with the necessary
+// decorators.
 func NewAnteHandler(
-	mempool *txpool.Mempool, cosmosAnteHandler sdk.AnteHandler,
+	mempool *txpool.Mempool, envelopes EnvelopeVerifier, params ParamsProvider,
+	cosmosAnteHandler sdk.AnteHandler,
 ) *Provider {
 	evmAnteDecorators := []sdk.AnteDecorator{
 		ante.NewSetUpContextDecorator(), // Set up the context decorator for the EVM ante handler
@@ -55,6 +72,9 @@ func NewAnteHandler(
 	return &Provider{
 		evmAnteHandler:    sdk.ChainAnteDecorators(evmAnteDecorators...),
 		cosmosAnteHandler: cosmosAnteHandler,
+		mempool:           mempool,
+		envelopes:         envelopes,
+		params:            params,
 	}
 }
 
@@ -64,22 +84,57 @@ func (ah *Provider) AnteHandler() func(
 	ctx sdk.Context, tx sdk.Tx, simulate bool,
 ) (sdk.Context, error) {
 	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		msgs := tx.GetMsgs()
+
+		// If the transaction contains a payload envelope, it must be the proposer's.
+		if evmtypes.ContainsPayloadEnvelope(msgs) {
+			return ah.envelopeAnteHandler(ctx, msgs)
+		}
+
 		// If the transaction contains a single EVM transaction, use the EVM ante handler
-		if len(tx.GetMsgs()) == 1 {
-			if _, ok := tx.GetMsgs()[0].(*evmtypes.WrappedEthereumTransaction); ok {
+		if len(msgs) == 1 {
+			if _, ok := msgs[0].(*evmtypes.WrappedEthereumTransaction); ok {
 				return ah.evmAnteHandler(ctx, tx, simulate)
-			} else if _, ok = tx.GetMsgs()[0].(*evmtypes.WrappedPayloadEnvelope); ok {
-				if ctx.ExecMode() != sdk.ExecModeCheck {
-					return ctx, nil
-				}
-				return ctx, errors.New("payload envelope is not supported in CheckTx")
 			}
 		}
 		// Otherwise, use the Cosmos ante handler
-		return ah.cosmosAnteHandler(ctx, tx, simulate)
+		newCtx, err := ah.cosmosAnteHandler(ctx, tx, simulate)
+		if err != nil && ctx.ExecMode() == sdk.ExecModeReCheck {
+			// The transaction is no longer valid, so we evict it from the cosmos lane
+			// of the mempool in order to prevent it from being selected for a proposal.
+			_ = ah.mempool.Remove(tx)
+		}
+		return newCtx, err
 	}
 }
 
+// envelopeAnteHandler handles transactions that contain a payload envelope. They are only
+// executed in FinalizeBlock, if the envelope is the one bound to the accepted proposal, and are
+// rejected in every other mode, e.g. by the mempool or when simulated.
+func (ah *Provider) envelopeAnteHandler(ctx sdk.Context, msgs []sdk.Msg) (sdk.Context, error) {
+	if ctx.ExecMode() != sdk.ExecModeFinalize || len(msgs) != 1 {
+		return ctx, evmtypes.ErrEnvelopeNotAllowed
+	}
+	if err := ah.envelopes.VerifyEnvelope(ctx); err != nil {
+		return ctx, err
+	}
+
+	envelope, err := msgs[0].(*evmtypes.WrappedPayloadEnvelope).UnwrapPayload()
+	if err != nil {
+		return ctx, err
+	}
+
+	// The envelope is metered on its own, so that only the gas charged for the evm block is
+	// consumed from the block gas meter. Its gas is limited to the evm lane of the block space,
+	// and to the gas limit of the evm block in case the block gas is unlimited.
+	params := ah.params.GetParams(ctx)
+	limit := min(
+		params.ConsensusBlockSpace(ctx).EVMMaxGas,
+		params.EVMGasToCosmos(envelope.ExecutionPayload.GasLimit),
+	)
+	return ctx.WithGasMeter(storetypes.NewGasMeter(limit)), nil
+}
+
 // EthSecp256k1SigVerificationGasConsumer is a function that consumes gas for the verification
 // of an Ethereum Secp256k1 signature.
 func EthSecp256k1SigVerificationGasConsumer(
@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ante
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	protov2 "google.golang.org/protobuf/proto"
+
+	evmtypes "github.com/berachain/polaris/cosmos/x/evm/types"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestAnte(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/runtime/ante")
+}
+
+// mockTx is a transaction with the given messages.
+type mockTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx *mockTx) GetMsgs() []sdk.Msg                    { return tx.msgs }
+func (tx *mockTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+
+// mockVerifier counts the envelopes that it verifies.
+type mockVerifier struct {
+	verified int
+}
+
+func (v *mockVerifier) VerifyEnvelope(sdk.Context) error {
+	v.verified++
+	return nil
+}
+
+// mockParams provides fixed x/evm params.
+type mockParams evmtypes.Params
+
+func (p mockParams) GetParams(context.Context) evmtypes.Params { return evmtypes.Params(p) }
+
+var _ = Describe("Envelope ante handler", func() {
+	var (
+		ctx      sdk.Context
+		verifier *mockVerifier
+		handler  sdk.AnteHandler
+		tx       *mockTx
+	)
+
+	BeforeEach(func() {
+		wrapped, err := evmtypes.WrapPayload(&engine.ExecutionPayloadEnvelope{
+			ExecutionPayload: &engine.ExecutableData{
+				GasLimit:      30_000_000,
+				BaseFeePerGas: big.NewInt(0),
+			},
+		}, evmtypes.EnvelopeEncoding_ENVELOPE_ENCODING_RLP)
+		Expect(err).ToNot(HaveOccurred())
+		tx = &mockTx{msgs: []sdk.Msg{wrapped}}
+
+		verifier = &mockVerifier{}
+		handler = NewAnteHandler(nil, verifier, mockParams{
+			CosmosGasReserveBps: 1000,
+			EvmGasRatioBps:      2 * evmtypes.BasisPoints,
+		}, nil).AnteHandler()
+	})
+
+	DescribeTable("should reject envelopes outside of FinalizeBlock",
+		func(mode sdk.ExecMode, simulate bool) {
+			_, err := handler(ctx.WithExecMode(mode), tx, simulate)
+			Expect(err).To(MatchError(evmtypes.ErrEnvelopeNotAllowed))
+			Expect(verifier.verified).To(BeZero())
+		},
+		Entry("in CheckTx", sdk.ExecModeCheck, false),
+		Entry("in ReCheckTx", sdk.ExecModeReCheck, false),
+		Entry("when simulated", sdk.ExecModeSimulate, true),
+		Entry("in PrepareProposal", sdk.ExecModePrepareProposal, false),
+		Entry("in ProcessProposal", sdk.ExecModeProcessProposal, false),
+		Entry("in VoteExtension", sdk.ExecModeVoteExtension, false),
+	)
+
+	It("should verify envelopes in FinalizeBlock", func() {
+		_, err := handler(ctx.WithExecMode(sdk.ExecModeFinalize), tx, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(verifier.verified).To(Equal(1))
+	})
+
+	It("should limit the envelope gas to the evm lane of the block space", func() {
+		ctx = ctx.WithExecMode(sdk.ExecModeFinalize).WithConsensusParams(cmtproto.ConsensusParams{
+			Block: &cmtproto.BlockParams{MaxGas: 50_000_000},
+		})
+		newCtx, err := handler(ctx, tx, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(newCtx.GasMeter().Limit()).To(Equal(uint64(45_000_000)))
+	})
+
+	It("should limit the envelope gas to the evm block gas limit if the block gas is unlimited",
+		func() {
+			ctx = ctx.WithExecMode(sdk.ExecModeFinalize).
+				WithConsensusParams(cmtproto.ConsensusParams{
+					Block: &cmtproto.BlockParams{MaxGas: -1},
+				})
+			newCtx, err := handler(ctx, tx, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(newCtx.GasMeter().Limit()).To(Equal(uint64(60_000_000)))
+		},
+	)
+
+	It("should reject envelopes bundled with other messages", func() {
+		tx.msgs = append(tx.msgs, tx.msgs[0])
+		_, err := handler(ctx.WithExecMode(sdk.ExecModeFinalize), tx, false)
+		Expect(err).To(MatchError(evmtypes.ErrEnvelopeNotAllowed))
+		Expect(verifier.verified).To(BeZero())
+	})
+})
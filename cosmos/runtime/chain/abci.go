@@ -21,54 +21,51 @@
 package chain
 
 import (
-	"fmt"
-
 	evmtypes "github.com/berachain/polaris/cosmos/x/evm/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	"github.com/ethereum/go-ethereum/beacon/engine"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
 func (wbc *WrappedBlockchain) ProcessProposal(
 	ctx sdk.Context, req *abci.RequestProcessProposal,
 ) (*abci.ResponseProcessProposal, error) {
-	var (
-		err error
-	)
-
-	// Pull an execution payload out of the proposal.
-	var envelope *engine.ExecutionPayloadEnvelope
-	for _, tx := range req.Txs {
-		var sdkTx sdk.Tx
-		sdkTx, err = wbc.app.TxDecode(tx)
-		if err != nil {
-			// should have been verified in prepare proposal, we
-			// ignore it for now (i.e VE extensions will fail decode).
-			continue
-		}
+	// Pull the execution payload out of the proposal, checking that it is the only one and that
+	// every other transaction is allowed to be included by validators.
+	envelope, valTxsGas, err := wbc.decodeProposal(ctx, req.Txs)
+	if err != nil {
+		ctx.Logger().Error("invalid proposal txs", "err", err)
+		return &abci.ResponseProcessProposal{
+			Status: abci.ResponseProcessProposal_REJECT,
+		}, err
+	}
 
-		protoEnvelope := sdkTx.GetMsgs()[0]
-		if env, ok := protoEnvelope.(*evmtypes.WrappedPayloadEnvelope); ok {
-			envelope = env.UnwrapPayload()
-			break
-		}
+	// Convert it to a block, which enforces the expected parent beacon root.
+	var block *ethtypes.Block
+	if block, err = evmtypes.EnvelopeToBlock(
+		wbc.Config(), envelope, evmtypes.ParentBeaconRoot(ctx),
+	); err != nil {
+		ctx.Logger().Error("failed to build evm block", "err", err)
+		return &abci.ResponseProcessProposal{
+			Status: abci.ResponseProcessProposal_REJECT,
+		}, err
 	}
 
-	// If the proposal doesn't contain an ethereum envelope, we should reject it.
-	if envelope == nil {
+	// Verify that the proposal fits within the block limits.
+	if err = wbc.verifyProposalLimits(ctx, req.Txs, block.GasUsed(), valTxsGas); err != nil {
+		ctx.Logger().Error("proposal exceeds block limits", "err", err)
 		return &abci.ResponseProcessProposal{
 			Status: abci.ResponseProcessProposal_REJECT,
-		}, fmt.Errorf("failed to find envelope in proposal")
+		}, err
 	}
 
-	// Convert it to a block.
-	var block *ethtypes.Block
-	if block, err = engine.ExecutableDataToBlock(*envelope.ExecutionPayload, nil, nil); err != nil {
-		ctx.Logger().Error("failed to build evm block", "err", err)
+	// Verify the payload attributes that the proposer is not free to choose.
+	if err = wbc.verifyPayloadAttributes(ctx, block); err != nil {
+		ctx.Logger().Error("invalid payload attributes", "err", err)
+		wbc.ReportBadBlock(block, err)
 		return &abci.ResponseProcessProposal{
 			Status: abci.ResponseProcessProposal_REJECT,
 		}, err
@@ -77,7 +74,7 @@ func (wbc *WrappedBlockchain) ProcessProposal(
 	spf := wbc.StatePluginFactory()
 	spf.SetInsertChainContext(ctx)
 
-	// Insert the block into the chain.å
+	// Insert the block into the chain, which records it as a bad block if it is invalid.
 	if err = wbc.InsertBlockWithoutSetHead(block); err != nil {
 		ctx.Logger().Error("failed to insert block", "err", err)
 		return &abci.ResponseProcessProposal{
@@ -85,6 +82,9 @@ func (wbc *WrappedBlockchain) ProcessProposal(
 		}, err
 	}
 
+	// Bind the envelope to the proposal, so that no other envelope is executed for the block.
+	wbc.bindEnvelope(ctx, req.Txs[0])
+
 	return &abci.ResponseProcessProposal{
 		Status: abci.ResponseProcessProposal_ACCEPT,
 	}, nil
@@ -21,20 +21,54 @@
 package chain
 
 import (
+	"github.com/berachain/polaris/cosmos/runtime/comet"
 	"github.com/berachain/polaris/eth/core"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/lru"
 )
 
+// envelopesCacheSize is the number of proposals whose envelopes are kept until FinalizeBlock, a
+// new proposal is accepted in every round of a height.
+const envelopesCacheSize = 8
+
 // WrappedBlockchain is a struct that wraps the core blockchain with additional
 // application context.
 type WrappedBlockchain struct {
-	core.Blockchain           // chain is the core blockchain.
-	app             txDecoder // App is the application context.
+	core.Blockchain                           // chain is the core blockchain.
+	app             txDecoder                 // App is the application context.
+	randao          comet.RandaoProvider      // randao provides the expected prevRandao of proposals.
+	withdrawals     comet.WithdrawalsProvider // withdrawals provides the expected withdrawals.
+	params          ParamsProvider            // params provides the msgs validators may include.
+
+	// envelopes holds the hashes of the payload envelopes bound to the proposals accepted in
+	// ProcessProposal by CometBFT block hash, lastEnvelopeHeight is the height at which an
+	// envelope was last executed.
+	envelopes          *lru.Cache[common.Hash, common.Hash]
+	lastEnvelopeHeight int64
+}
+
+// New creates a new instance of WrappedBlockchain with the provided core blockchain,
+// application context and the provider of the params that govern proposals.
+func New(chain core.Blockchain, app txDecoder, params ParamsProvider) *WrappedBlockchain {
+	return &WrappedBlockchain{
+		Blockchain:  chain,
+		app:         app,
+		randao:      comet.NewRandaoProvider(),
+		withdrawals: comet.NewNoWithdrawalsProvider(),
+		params:      params,
+		envelopes:   lru.NewCache[common.Hash, common.Hash](envelopesCacheSize),
+	}
+}
+
+// SetWithdrawalsProvider sets the provider of the expected withdrawals of proposals.
+func (wbc *WrappedBlockchain) SetWithdrawalsProvider(withdrawals comet.WithdrawalsProvider) {
+	wbc.withdrawals = withdrawals
 }
 
-// New creates a new instance of WrappedBlockchain with the provided core blockchain
-// and application context.
-func New(chain core.Blockchain, app txDecoder) *WrappedBlockchain {
-	return &WrappedBlockchain{Blockchain: chain, app: app}
+// SetRandaoProvider sets the provider of the expected prevRandao of proposals.
+func (wbc *WrappedBlockchain) SetRandaoProvider(randao comet.RandaoProvider) {
+	wbc.randao = randao
 }
 
 func (wbc *WrappedBlockchain) SetBlockchain(chain core.Blockchain) {
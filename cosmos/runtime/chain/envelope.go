@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chain
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	// ErrUnboundEnvelope is returned when the payload envelope executed in FinalizeBlock is not
+	// the one of the proposal that was accepted for the block.
+	ErrUnboundEnvelope = errors.New("payload envelope is not bound to the proposal")
+
+	// ErrEnvelopeReplayed is returned when a payload envelope is executed more than once at a
+	// height.
+	ErrEnvelopeReplayed = errors.New("payload envelope is already executed in the block")
+)
+
+// bindEnvelope binds the bytes of the payload envelope to the proposal accepted at the given
+// ProcessProposal context, which is identified by its block hash.
+func (wbc *WrappedBlockchain) bindEnvelope(ctx sdk.Context, envelopeBz []byte) {
+	wbc.envelopes.Add(common.BytesToHash(ctx.HeaderHash()), crypto.Keccak256Hash(envelopeBz))
+}
+
+// VerifyEnvelope checks that the payload envelope executed at the given FinalizeBlock context is
+// the one bound to the block in ProcessProposal, and that it is the only one executed at its
+// height. Blocks that have not been processed by this node, e.g. as it is block syncing or has
+// restarted, have been accepted by the network, so the envelope that comes first is bound to
+// them on execution.
+func (wbc *WrappedBlockchain) VerifyEnvelope(ctx sdk.Context) error {
+	if ctx.BlockHeight() == wbc.lastEnvelopeHeight {
+		return ErrEnvelopeReplayed
+	}
+	blockHash := common.BytesToHash(ctx.HeaderHash())
+	if hash, ok := wbc.envelopes.Get(blockHash); ok &&
+		hash != crypto.Keccak256Hash(ctx.TxBytes()) {
+		return ErrUnboundEnvelope
+	}
+
+	wbc.envelopes.Remove(blockHash)
+	wbc.lastEnvelopeHeight = ctx.BlockHeight()
+	return nil
+}
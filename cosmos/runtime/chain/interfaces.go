@@ -21,9 +21,19 @@
 package chain
 
 import (
+	"context"
+
+	evmtypes "github.com/berachain/polaris/cosmos/x/evm/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 type txDecoder interface {
 	TxDecode(txBz []byte) (sdk.Tx, error)
 }
+
+// ParamsProvider provides the x/evm params, which hold the messages that validators are allowed to
+// include in proposals, at the given context.
+type ParamsProvider interface {
+	GetParams(ctx context.Context) evmtypes.Params
+}
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chain
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// ErrInvalidPrevRandao is returned when a proposed block has an unexpected MixDigest.
+var ErrInvalidPrevRandao = errors.New("invalid prevRandao")
+
+// ErrInvalidWithdrawals is returned when the withdrawals of a proposed block do not match the
+// ones expected by the host chain.
+var ErrInvalidWithdrawals = errors.New("invalid withdrawals")
+
+// verifyPayloadAttributes checks that the payload attributes of a proposed block, which are
+// derived from the host chain rather than chosen by the proposer, match the expected values.
+func (wbc *WrappedBlockchain) verifyPayloadAttributes(
+	ctx sdk.Context, block *ethtypes.Block,
+) error {
+	random, err := wbc.randao.PrevRandao(ctx)
+	if err != nil {
+		return err
+	}
+	if block.MixDigest() != random {
+		return fmt.Errorf(
+			"%w: have %s, want %s", ErrInvalidPrevRandao, block.MixDigest(), random,
+		)
+	}
+
+	expected, err := wbc.withdrawals.Withdrawals(ctx)
+	if err != nil {
+		return err
+	}
+	return verifyWithdrawals(block.Withdrawals(), expected)
+}
+
+// verifyWithdrawals checks that the withdrawals of a block match the expected ones one by one.
+func verifyWithdrawals(have, want ethtypes.Withdrawals) error {
+	if len(have) != len(want) {
+		return fmt.Errorf(
+			"%w: have %d withdrawals, want %d", ErrInvalidWithdrawals, len(have), len(want),
+		)
+	}
+	for i := range want {
+		if *have[i] != *want[i] {
+			return fmt.Errorf(
+				"%w: mismatch at index %d: have %+v, want %+v",
+				ErrInvalidWithdrawals, i, *have[i], *want[i],
+			)
+		}
+	}
+	return nil
+}
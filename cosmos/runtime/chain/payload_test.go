@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chain
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// mockRandao provides a fixed prevRandao.
+type mockRandao common.Hash
+
+func (r mockRandao) PrevRandao(sdk.Context) (common.Hash, error) { return common.Hash(r), nil }
+
+// mockWithdrawals provides fixed withdrawals.
+type mockWithdrawals ethtypes.Withdrawals
+
+func (w mockWithdrawals) Withdrawals(sdk.Context) (ethtypes.Withdrawals, error) {
+	return ethtypes.Withdrawals(w), nil
+}
+
+var _ = Describe("Payload attributes", func() {
+	var (
+		ctx    sdk.Context
+		wbc    *WrappedBlockchain
+		random = common.Hash{0x01}
+	)
+
+	BeforeEach(func() {
+		wbc = New(nil, nil, nil)
+		wbc.SetRandaoProvider(mockRandao(random))
+	})
+
+	DescribeTable("verifying the prevRandao",
+		func(mixDigest common.Hash, expected error) {
+			block := ethtypes.NewBlockWithHeader(&ethtypes.Header{MixDigest: mixDigest})
+			if expected == nil {
+				Expect(wbc.verifyPayloadAttributes(ctx, block)).To(Succeed())
+			} else {
+				Expect(wbc.verifyPayloadAttributes(ctx, block)).To(MatchError(expected))
+			}
+		},
+		Entry("matching", random, nil),
+		Entry("mismatched", common.Hash{0x02}, ErrInvalidPrevRandao),
+		Entry("zero", common.Hash{}, ErrInvalidPrevRandao),
+	)
+
+	It("should verify the withdrawals of the block", func() {
+		expected := ethtypes.Withdrawals{{Index: 1, Validator: 2, Amount: 3}}
+		wbc.SetWithdrawalsProvider(mockWithdrawals(expected))
+		header := &ethtypes.Header{MixDigest: random}
+
+		block := ethtypes.NewBlockWithHeader(header).WithWithdrawals(expected)
+		Expect(wbc.verifyPayloadAttributes(ctx, block)).To(Succeed())
+		Expect(wbc.verifyPayloadAttributes(ctx, ethtypes.NewBlockWithHeader(header))).
+			To(MatchError(ErrInvalidWithdrawals))
+	})
+})
+
+var _ = DescribeTable("Verifying withdrawals",
+	func(have ethtypes.Withdrawals, expected error) {
+		want := ethtypes.Withdrawals{
+			{Index: 1, Validator: 2, Address: common.Address{0x03}, Amount: 4},
+			{Index: 2, Validator: 5, Address: common.Address{0x06}, Amount: 7},
+		}
+		if expected == nil {
+			Expect(verifyWithdrawals(have, want)).To(Succeed())
+		} else {
+			Expect(verifyWithdrawals(have, want)).To(MatchError(expected))
+		}
+	},
+	Entry("matching", ethtypes.Withdrawals{
+		{Index: 1, Validator: 2, Address: common.Address{0x03}, Amount: 4},
+		{Index: 2, Validator: 5, Address: common.Address{0x06}, Amount: 7},
+	}, nil),
+	Entry("missing", ethtypes.Withdrawals{
+		{Index: 1, Validator: 2, Address: common.Address{0x03}, Amount: 4},
+	}, ErrInvalidWithdrawals),
+	Entry("extra", ethtypes.Withdrawals{
+		{Index: 1, Validator: 2, Address: common.Address{0x03}, Amount: 4},
+		{Index: 2, Validator: 5, Address: common.Address{0x06}, Amount: 7},
+		{Index: 3, Validator: 8, Address: common.Address{0x09}, Amount: 10},
+	}, ErrInvalidWithdrawals),
+	Entry("none", nil, ErrInvalidWithdrawals),
+	Entry("wrong amount at an index", ethtypes.Withdrawals{
+		{Index: 1, Validator: 2, Address: common.Address{0x03}, Amount: 4},
+		{Index: 2, Validator: 5, Address: common.Address{0x06}, Amount: 8},
+	}, ErrInvalidWithdrawals),
+	Entry("wrong address at an index", ethtypes.Withdrawals{
+		{Index: 1, Validator: 2, Address: common.Address{0x04}, Amount: 4},
+		{Index: 2, Validator: 5, Address: common.Address{0x06}, Amount: 7},
+	}, ErrInvalidWithdrawals),
+	Entry("out of order", ethtypes.Withdrawals{
+		{Index: 2, Validator: 5, Address: common.Address{0x06}, Amount: 7},
+		{Index: 1, Validator: 2, Address: common.Address{0x03}, Amount: 4},
+	}, ErrInvalidWithdrawals),
+)
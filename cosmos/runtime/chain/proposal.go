@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chain
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	evmtypes "github.com/berachain/polaris/cosmos/x/evm/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+)
+
+var (
+	// ErrMissingEnvelope is returned when a proposal does not start with a payload envelope.
+	ErrMissingEnvelope = errors.New("proposal does not start with a payload envelope")
+
+	// ErrExtraEnvelope is returned when a proposal contains more than one payload envelope.
+	ErrExtraEnvelope = errors.New("proposal contains more than one payload envelope")
+
+	// ErrDisallowedMsg is returned when a proposal contains a message that validators are not
+	// allowed to include.
+	ErrDisallowedMsg = errors.New("proposal contains a disallowed message")
+
+	// ErrProposalTooLarge is returned when the transactions of a proposal exceed the block byte
+	// or gas limits.
+	ErrProposalTooLarge = errors.New("proposal exceeds the block limits")
+)
+
+// decodeProposal checks that a proposal consists of exactly one payload envelope, placed first,
+// followed by transactions of messages allowed by the params at the given context only. It
+// returns the envelope along with the total gas limit of the other transactions.
+func (wbc *WrappedBlockchain) decodeProposal(
+	ctx sdk.Context, txs [][]byte,
+) (*engine.ExecutionPayloadEnvelope, uint64, error) {
+	if len(txs) == 0 {
+		return nil, 0, ErrMissingEnvelope
+	}
+
+	tx, err := wbc.app.TxDecode(txs[0])
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: %w", ErrMissingEnvelope, err)
+	}
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return nil, 0, ErrMissingEnvelope
+	}
+	wrapped, ok := msgs[0].(*evmtypes.WrappedPayloadEnvelope)
+	if !ok {
+		return nil, 0, ErrMissingEnvelope
+	}
+
+	var (
+		params    = wbc.params.GetParams(ctx)
+		valTxsGas uint64
+	)
+	for i, txBz := range txs[1:] {
+		if tx, err = wbc.app.TxDecode(txBz); err != nil {
+			return nil, 0, fmt.Errorf("failed to decode tx at index %d: %w", i+1, err)
+		}
+		for _, msg := range tx.GetMsgs() {
+			if _, ok = msg.(*evmtypes.WrappedPayloadEnvelope); ok {
+				return nil, 0, fmt.Errorf("%w: at index %d", ErrExtraEnvelope, i+1)
+			}
+			if !params.IsAllowedValidatorMsg(proto.MessageName(msg)) {
+				return nil, 0, fmt.Errorf(
+					"%w: %s at index %d", ErrDisallowedMsg, proto.MessageName(msg), i+1,
+				)
+			}
+		}
+		if gasTx, isGasTx := tx.(baseapp.GasTx); isGasTx {
+			valTxsGas += gasTx.GetGas()
+		}
+	}
+
+	envelope, err := wrapped.UnwrapPayload()
+	if err != nil {
+		return nil, 0, err
+	}
+	return envelope, valTxsGas, nil
+}
+
+// verifyProposalLimits checks that the transactions of a proposal respect the partitioning of the
+// block space between the payload envelope and the other transactions, as governed by the params
+// at the given context.
+func (wbc *WrappedBlockchain) verifyProposalLimits(
+	ctx sdk.Context, txs [][]byte, ethGasUsed, valTxsGas uint64,
+) error {
+	// The proposer partitions the same space, which it further limits to the max bytes of the
+	// txs of the proposal, so any proposal that it builds passes this check.
+	space := wbc.params.GetParams(ctx).ConsensusBlockSpace(ctx)
+	if err := space.Verify(
+		evmtypes.TxsSize(txs[0]), ethGasUsed, evmtypes.TxsSize(txs[1:]...), valTxsGas,
+	); err != nil {
+		return fmt.Errorf("%w: %w", ErrProposalTooLarge, err)
+	}
+	return nil
+}
@@ -0,0 +1,199 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chain
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	protov2 "google.golang.org/protobuf/proto"
+
+	evmtypes "github.com/berachain/polaris/cosmos/x/evm/types"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestChain(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/runtime/chain")
+}
+
+// mockTx is a transaction with the given messages and gas limit.
+type mockTx struct {
+	msgs []sdk.Msg
+	gas  uint64
+}
+
+func (tx *mockTx) GetMsgs() []sdk.Msg                    { return tx.msgs }
+func (tx *mockTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+func (tx *mockTx) GetGas() uint64                        { return tx.gas }
+
+// mockDecoder decodes transactions by looking them up by their bytes.
+type mockDecoder map[string]sdk.Tx
+
+func (d mockDecoder) TxDecode(txBz []byte) (sdk.Tx, error) {
+	if tx, ok := d[string(txBz)]; ok {
+		return tx, nil
+	}
+	return nil, errors.New("unknown tx")
+}
+
+// mockParams provides fixed x/evm params.
+type mockParams evmtypes.Params
+
+func (p mockParams) GetParams(context.Context) evmtypes.Params { return evmtypes.Params(p) }
+
+var _ = Describe("Proposal", func() {
+	var (
+		ctx      sdk.Context
+		wbc      *WrappedBlockchain
+		envelope = []byte("envelope")
+		vote     = []byte("vote")
+		send     = []byte("send")
+	)
+
+	BeforeEach(func() {
+		wrapped, err := evmtypes.WrapPayload(&engine.ExecutionPayloadEnvelope{
+			ExecutionPayload: &engine.ExecutableData{BaseFeePerGas: big.NewInt(0)},
+		}, evmtypes.EnvelopeEncoding_ENVELOPE_ENCODING_RLP)
+		Expect(err).ToNot(HaveOccurred())
+		decoder := mockDecoder{
+			string(envelope): &mockTx{
+				msgs: []sdk.Msg{wrapped},
+			},
+			string(vote): &mockTx{msgs: []sdk.Msg{&govtypes.MsgVote{}}, gas: 100},
+			string(send): &mockTx{msgs: []sdk.Msg{&banktypes.MsgSend{}}, gas: 100},
+		}
+		wbc = New(nil, decoder, mockParams{
+			AllowedValidatorMsgs: []string{"cosmos.gov.v1.MsgVote"},
+			EvmGasRatioBps:       evmtypes.BasisPoints,
+		})
+	})
+
+	It("should accept an envelope followed by allowed txs", func() {
+		_, gas, err := wbc.decodeProposal(ctx, [][]byte{envelope, vote, vote})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(gas).To(Equal(uint64(200)))
+	})
+
+	It("should require the envelope to come first", func() {
+		_, _, err := wbc.decodeProposal(ctx, nil)
+		Expect(err).To(MatchError(ErrMissingEnvelope))
+		_, _, err = wbc.decodeProposal(ctx, [][]byte{vote, envelope})
+		Expect(err).To(MatchError(ErrMissingEnvelope))
+	})
+
+	It("should reject a second envelope", func() {
+		_, _, err := wbc.decodeProposal(ctx, [][]byte{envelope, vote, envelope})
+		Expect(err).To(MatchError(ErrExtraEnvelope))
+	})
+
+	It("should reject disallowed and undecodable txs", func() {
+		_, _, err := wbc.decodeProposal(ctx, [][]byte{envelope, send})
+		Expect(err).To(MatchError(ErrDisallowedMsg))
+		_, _, err = wbc.decodeProposal(ctx, [][]byte{envelope, []byte("garbage")})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should enforce the block byte and gas limits", func() {
+		// The envelope and the vote take up 10 and 6 bytes with their protobuf framing.
+		ctx = ctx.WithConsensusParams(cmtproto.ConsensusParams{
+			Block: &cmtproto.BlockParams{MaxBytes: 21, MaxGas: 1000},
+		})
+		txs := [][]byte{envelope, vote}
+		Expect(wbc.verifyProposalLimits(ctx, txs, 900, 100)).To(Succeed())
+		Expect(wbc.verifyProposalLimits(ctx, txs, 900, 101)).To(MatchError(ErrProposalTooLarge))
+		Expect(wbc.verifyProposalLimits(ctx, txs, 1001, 0)).To(MatchError(ErrProposalTooLarge))
+		Expect(wbc.verifyProposalLimits(ctx, append(txs, vote), 0, 0)).
+			To(MatchError(ErrProposalTooLarge))
+
+		// A negative max gas leaves the block gas unlimited.
+		ctx = ctx.WithConsensusParams(cmtproto.ConsensusParams{
+			Block: &cmtproto.BlockParams{MaxBytes: 21, MaxGas: -1},
+		})
+		Expect(wbc.verifyProposalLimits(ctx, txs, 1<<40, 1<<40)).To(Succeed())
+	})
+
+	It("should keep the envelope out of the reserved block space", func() {
+		wbc.params = mockParams{
+			CosmosGasReserveBps:   2000,
+			CosmosBytesReserveBps: 5000,
+			EvmGasRatioBps:        evmtypes.BasisPoints,
+		}
+		ctx = ctx.WithConsensusParams(cmtproto.ConsensusParams{
+			Block: &cmtproto.BlockParams{MaxBytes: 20, MaxGas: 1000},
+		})
+		txs := [][]byte{envelope, vote}
+		Expect(wbc.verifyProposalLimits(ctx, txs, 800, 0)).To(Succeed())
+		Expect(wbc.verifyProposalLimits(ctx, txs, 801, 0)).
+			To(MatchError(evmtypes.ErrBlockSpaceExceeded))
+
+		// The txs may use the space that the envelope leaves over.
+		Expect(wbc.verifyProposalLimits(ctx, txs, 100, 900)).To(Succeed())
+		Expect(wbc.verifyProposalLimits(ctx, [][]byte{append(envelope, 'x'), vote}, 0, 0)).
+			To(MatchError(evmtypes.ErrBlockSpaceExceeded))
+	})
+
+	It("should convert the evm gas used with the gas ratio", func() {
+		wbc.params = mockParams{EvmGasRatioBps: 2 * evmtypes.BasisPoints}
+		ctx = ctx.WithConsensusParams(cmtproto.ConsensusParams{
+			Block: &cmtproto.BlockParams{MaxBytes: 21, MaxGas: 1000},
+		})
+		txs := [][]byte{envelope, vote}
+		Expect(wbc.verifyProposalLimits(ctx, txs, 450, 100)).To(Succeed())
+		Expect(wbc.verifyProposalLimits(ctx, txs, 451, 100)).To(MatchError(ErrProposalTooLarge))
+		Expect(wbc.verifyProposalLimits(ctx, txs, 501, 0)).To(MatchError(ErrProposalTooLarge))
+	})
+
+	Describe("envelope binding", func() {
+		BeforeEach(func() {
+			ctx = ctx.WithBlockHeight(1).WithHeaderHash([]byte("block"))
+			wbc.bindEnvelope(ctx, envelope)
+		})
+
+		It("should accept the bound envelope once", func() {
+			Expect(wbc.VerifyEnvelope(ctx.WithTxBytes(envelope))).To(Succeed())
+			Expect(wbc.VerifyEnvelope(ctx.WithTxBytes(envelope))).
+				To(MatchError(ErrEnvelopeReplayed))
+		})
+
+		It("should reject an envelope that is not bound to the block", func() {
+			Expect(wbc.VerifyEnvelope(ctx.WithTxBytes([]byte("other")))).
+				To(MatchError(ErrUnboundEnvelope))
+		})
+
+		It("should accept the envelope of a block that was not processed", func() {
+			ctx = ctx.WithBlockHeight(2).WithHeaderHash([]byte("synced"))
+			Expect(wbc.VerifyEnvelope(ctx.WithTxBytes([]byte("other")))).To(Succeed())
+		})
+	})
+})
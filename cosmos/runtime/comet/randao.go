@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package comet
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// cometRandao derives the prevRandao of a payload from CometBFT block data.
+type cometRandao struct{}
+
+// NewRandaoProvider returns a randomness provider that derives the prevRandao of a payload from
+// the app hash of the last committed block and the votes in the last commit of the proposal,
+// which are available to the proposer in PrepareProposal as well as to every validator in
+// ProcessProposal.
+//
+// The value is biasable by the proposer, which chooses the last commit of its proposal. The
+// app hash is fixed, but any precommits beyond the +2/3 of the voting power that the commit
+// needs may be left out, so a proposer can grind through up to 2^n values, where n is the number
+// of such votes it received, and propose the one it prefers. It cannot choose a value outside
+// of these, and every validator can compute the value once the proposal is known. Contracts must
+// not rely on it where a proposer gains from biasing it.
+func NewRandaoProvider() *cometRandao { //nolint:revive // only used as interface.
+	return &cometRandao{}
+}
+
+// PrevRandao returns keccak256(lastAppHash || height || lastCommitVotes).
+func (cometRandao) PrevRandao(ctx sdk.Context) (common.Hash, error) {
+	var height [8]byte
+	binary.BigEndian.PutUint64(height[:], uint64(ctx.BlockHeight()))
+
+	data := [][]byte{ctx.BlockHeader().AppHash, height[:]}
+	for _, vote := range ctx.VoteInfos() {
+		data = append(data, vote.Validator.Address, []byte{byte(vote.BlockIdFlag)})
+	}
+	return crypto.Keccak256Hash(data...), nil
+}
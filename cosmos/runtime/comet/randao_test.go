@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package comet_test
+
+import (
+	"testing"
+
+	"github.com/berachain/polaris/cosmos/runtime/comet"
+
+	cometabci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestComet(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/runtime/comet")
+}
+
+var _ = Describe("Randao", func() {
+	var (
+		randao = comet.NewRandaoProvider()
+		votes  = []cometabci.VoteInfo{
+			{
+				Validator:   cometabci.Validator{Address: []byte("validator1"), Power: 1},
+				BlockIdFlag: cmtproto.BlockIDFlagCommit,
+			},
+			{
+				Validator:   cometabci.Validator{Address: []byte("validator2"), Power: 1},
+				BlockIdFlag: cmtproto.BlockIDFlagAbsent,
+			},
+		}
+		ctx sdk.Context
+	)
+
+	BeforeEach(func() {
+		ctx = sdk.Context{}.
+			WithBlockHeader(cmtproto.Header{Height: 7, AppHash: []byte("apphash")}).
+			WithVoteInfos(votes)
+	})
+
+	It("should be deterministic for the same header and votes", func() {
+		random, err := randao.PrevRandao(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		again, err := randao.PrevRandao(sdk.Context{}.
+			WithBlockHeader(cmtproto.Header{Height: 7, AppHash: []byte("apphash")}).
+			WithVoteInfos(append([]cometabci.VoteInfo{}, votes...)))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(again).To(Equal(random))
+		Expect(random).ToNot(BeZero())
+	})
+
+	DescribeTable("should change with the block data",
+		func(modify func(sdk.Context) sdk.Context) {
+			random, err := randao.PrevRandao(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			other, err := randao.PrevRandao(modify(ctx))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(other).ToNot(Equal(random))
+		},
+		Entry("app hash", func(ctx sdk.Context) sdk.Context {
+			header := ctx.BlockHeader()
+			header.AppHash = []byte("other")
+			return ctx.WithBlockHeader(header)
+		}),
+		Entry("height", func(ctx sdk.Context) sdk.Context {
+			return ctx.WithBlockHeight(8)
+		}),
+		Entry("left out vote", func(ctx sdk.Context) sdk.Context {
+			return ctx.WithVoteInfos(votes[:1])
+		}),
+		Entry("vote flag", func(ctx sdk.Context) sdk.Context {
+			return ctx.WithVoteInfos([]cometabci.VoteInfo{votes[0], {
+				Validator:   votes[1].Validator,
+				BlockIdFlag: cmtproto.BlockIDFlagCommit,
+			}})
+		}),
+	)
+})
@@ -22,16 +22,13 @@
 package miner
 
 import (
+	evmtypes "github.com/berachain/polaris/cosmos/x/evm/types"
+
 	abci "github.com/cometbft/cometbft/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-
-	"github.com/ethereum/go-ethereum/common"
 )
 
-// emptyHash is a common.Hash initialized to all zeros.
-var emptyHash = common.Hash{}
-
 // PrepareProposal implements baseapp.PrepareProposal.
 func (m *Miner) PrepareProposal(
 	ctx sdk.Context, req *abci.RequestPrepareProposal,
@@ -46,13 +43,20 @@ func (m *Miner) PrepareProposal(
 	// Set the mining context for the state plugin factory.
 	m.spf.SetLatestMiningContext(ctx)
 
+	// Partition the block space between the payload envelope and the validator transactions,
+	// as governed by the params at this height.
+	params := m.params.GetParams(ctx)
+	space := params.ConsensusBlockSpace(ctx).LimitTxBytes(req.MaxTxBytes)
+
 	// Trigger the geth miner to build a block.
-	if payloadEnvelopeBz, ethGasUsed, err = m.buildBlock(ctx); err != nil {
+	if payloadEnvelopeBz, ethGasUsed, err = m.buildBlock(ctx, params, space); err != nil {
 		return nil, err
 	}
 
 	// Process the validator messages.
-	if valTxs, err = m.processValidatorMsgs(ctx, req.MaxTxBytes, ethGasUsed, req.Txs); err != nil {
+	if valTxs, err = m.processValidatorMsgs(
+		ctx, params, space, evmtypes.TxsSize(payloadEnvelopeBz), ethGasUsed, req.Txs,
+	); err != nil {
 		return nil, err
 	}
 
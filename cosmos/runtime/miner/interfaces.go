@@ -23,22 +23,55 @@ package miner
 import (
 	"context"
 
+	evmtypes "github.com/berachain/polaris/cosmos/x/evm/types"
+	"github.com/berachain/polaris/eth"
 	"github.com/berachain/polaris/eth/core"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 
 	"github.com/ethereum/go-ethereum/beacon/engine"
 )
 
-// EnvelopeSerializer is used to convert an envelope into a byte slice that represents
+// EnvelopeSerializer is used to convert a wrapped envelope into a byte slice that represents
 // a cosmos sdk.Tx.
 type (
 	EnvelopeSerializer interface {
-		ToSdkTxBytes(*engine.ExecutionPayloadEnvelope, uint64) ([]byte, error)
+		ToSdkTxBytes(*evmtypes.WrappedPayloadEnvelope, uint64) ([]byte, error)
 	}
 
-	TxDecoder interface {
+	// TxCodec is used to decode and encode cosmos transactions.
+	TxCodec interface {
 		TxDecode(txBytes []byte) (sdk.Tx, error)
+		TxEncode(tx sdk.Tx) ([]byte, error)
+	}
+
+	// MinerProvider provides the geth miner, which is rebuilt when the chain config changes.
+	MinerProvider interface {
+		Miner() eth.Miner
+	}
+
+	// Mempool is the app-side mempool that holds the cosmos transactions that are
+	// eligible for inclusion in a proposal.
+	Mempool interface {
+		Select(context.Context, [][]byte) mempool.Iterator
+		Remove(sdk.Tx) error
+	}
+
+	// Payload is a payload that is built by the geth miner.
+	Payload interface {
+		// Resolve returns the best payload built so far and stops building it.
+		Resolve() *engine.ExecutionPayloadEnvelope
+		// ResolveEmpty returns the empty payload.
+		ResolveEmpty() *engine.ExecutionPayloadEnvelope
+		// ResolveFull waits for the full payload to be built and stops building it.
+		ResolveFull() *engine.ExecutionPayloadEnvelope
+	}
+
+	// ParamsProvider provides the x/evm params, which hold the messages that validators are
+	// allowed to include in proposals, at the given context.
+	ParamsProvider interface {
+		GetParams(ctx context.Context) evmtypes.Params
 	}
 
 	// EVMKeeper is an interface that defines the methods needed for the EVM setup.
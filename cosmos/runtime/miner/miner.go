@@ -23,43 +23,60 @@ package miner
 
 import (
 	"context"
+	"errors"
+	"math"
 	"time"
 
 	"github.com/cosmos/gogoproto/proto"
 
-	"github.com/berachain/polaris/eth"
+	"github.com/berachain/polaris/cosmos/runtime/comet"
+	evmtypes "github.com/berachain/polaris/cosmos/x/evm/types"
 	"github.com/berachain/polaris/eth/core"
 
+	cmttypes "github.com/cometbft/cometbft/types"
+
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 
-	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/beacon/engine"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/miner"
 )
 
 // Miner implements the baseapp.TxSelector interface.
 type Miner struct {
-	miner          eth.Miner
-	app            TxDecoder
+	minerProvider  MinerProvider
+	app            TxCodec
+	mempool        Mempool
 	spf            core.StatePluginFactory
 	valTxSelector  baseapp.TxSelector
 	serializer     EnvelopeSerializer
-	allowedValMsgs map[string]sdk.Msg
-	currentPayload *miner.Payload
+	params         ParamsProvider
+	randao         comet.RandaoProvider
+	withdrawals    comet.WithdrawalsProvider
+	gasCeil        uint64
+	buildTimeout   time.Duration
+	currentPayload Payload
 }
 
-// New produces a cosmos miner from a geth miner.
+// New produces a cosmos miner from the geth miner of the provider.
 func New(
-	miner eth.Miner, app TxDecoder, spf core.StatePluginFactory, allowedValMsgs map[string]sdk.Msg,
+	minerProvider MinerProvider, app TxCodec, mempool Mempool,
+	spf core.StatePluginFactory, params ParamsProvider, gasCeil uint64, buildTimeout time.Duration,
 ) *Miner {
 	return &Miner{
-		miner:          miner,
-		app:            app,
-		spf:            spf,
-		allowedValMsgs: allowedValMsgs,
-		valTxSelector:  baseapp.NewDefaultTxSelector(),
+		minerProvider: minerProvider,
+		app:           app,
+		mempool:       mempool,
+		spf:           spf,
+		params:        params,
+		gasCeil:       gasCeil,
+		buildTimeout:  buildTimeout,
+		valTxSelector: baseapp.NewDefaultTxSelector(),
+		randao:        comet.NewRandaoProvider(),
+		withdrawals:   comet.NewNoWithdrawalsProvider(),
 	}
 }
 
@@ -68,18 +85,37 @@ func (m *Miner) Init(serializer EnvelopeSerializer) {
 	m.serializer = serializer
 }
 
+// SetRandaoProvider sets the provider of the prevRandao of built payloads.
+func (m *Miner) SetRandaoProvider(randao comet.RandaoProvider) {
+	m.randao = randao
+}
+
+// SetWithdrawalsProvider sets the provider of the withdrawals of built payloads.
+func (m *Miner) SetWithdrawalsProvider(withdrawals comet.WithdrawalsProvider) {
+	m.withdrawals = withdrawals
+}
+
 // buildBlock builds and submits a payload, it also waits for the txs
-// to resolve from the underying worker.
-func (m *Miner) buildBlock(ctx sdk.Context) ([]byte, uint64, error) {
+// to resolve from the underying worker until the build deadline.
+func (m *Miner) buildBlock(
+	ctx sdk.Context, params evmtypes.Params, space evmtypes.BlockSpace,
+) ([]byte, uint64, error) {
 	defer m.clearPayload()
 
 	// Record the time it takes to build a payload.
-	defer telemetry.MeasureSince(time.Now(), MetricKeyBuildBlock)
+	start := time.Now()
+	defer telemetry.MeasureSince(start, MetricKeyBuildBlock)
+	deadline := m.buildDeadline(ctx, start)
+
+	// Build the payload against the evm lane of the block space, so that the payloads of busy
+	// pools fit in it. The gas limit of blocks moves towards the ceiling gradually, as bound by
+	// the consensus rules, so it takes a number of blocks to adopt a new lane.
+	m.minerProvider.Miner().SetGasCeil(min(m.gasCeil, params.CosmosGasToEVM(space.EVMMaxGas)))
 
 	if err := m.submitPayloadForBuilding(ctx); err != nil {
 		return nil, 0, err
 	}
-	env, gasUsed := m.resolveEnvelope()
+	env, gasUsed := m.resolveEnvelope(ctx, params, space, deadline)
 
 	return env, gasUsed, nil
 }
@@ -88,12 +124,18 @@ func (m *Miner) buildBlock(ctx sdk.Context) ([]byte, uint64, error) {
 func (m *Miner) submitPayloadForBuilding(ctx context.Context) error {
 	var (
 		err     error
+		args    *miner.BuildPayloadArgs
 		payload *miner.Payload
 		sCtx    = sdk.UnwrapSDKContext(ctx)
 	)
 
+	if args, err = m.constructPayloadArgs(sCtx); err != nil {
+		sCtx.Logger().Error("failed to construct payload args", "err", err)
+		return err
+	}
+
 	// Build Payload
-	if payload, err = m.miner.BuildPayload(m.constructPayloadArgs(sCtx)); err != nil {
+	if payload, err = m.minerProvider.Miner().BuildPayload(args); err != nil {
 		sCtx.Logger().Error("failed to build payload", "err", err)
 		return err
 	}
@@ -103,34 +145,114 @@ func (m *Miner) submitPayloadForBuilding(ctx context.Context) error {
 }
 
 // constructPayloadArgs builds a payload to submit to the miner.
-func (m *Miner) constructPayloadArgs(ctx sdk.Context) *miner.BuildPayloadArgs {
+func (m *Miner) constructPayloadArgs(ctx sdk.Context) (*miner.BuildPayloadArgs, error) {
+	random, err := m.randao.PrevRandao(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	withdrawals, err := m.withdrawals.Withdrawals(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if withdrawals == nil {
+		// Withdrawals must be non-nil post-Shanghai.
+		withdrawals = make(ethtypes.Withdrawals, 0)
+	}
+
+	beaconRoot := evmtypes.ParentBeaconRoot(ctx)
 	return &miner.BuildPayloadArgs{
 		Timestamp:    uint64(ctx.BlockTime().Unix()),
-		FeeRecipient: m.miner.Etherbase(),
-		Random:       common.Hash{}, /* todo: generated random */
-		Withdrawals:  make(ethtypes.Withdrawals, 0),
-		BeaconRoot:   &emptyHash,
-	}
+		FeeRecipient: m.minerProvider.Miner().Etherbase(),
+		Random:       random,
+		Withdrawals:  withdrawals,
+		BeaconRoot:   &beaconRoot,
+	}, nil
 }
 
-// resolveEnvelope resolves the payload.
-func (m *Miner) resolveEnvelope() ([]byte, uint64) {
+// resolveEnvelope resolves the payload in the envelope encoding of the params. The payload is
+// built against the gas of the evm lane of the block space, but it may exceed the lane while the
+// gas limit of blocks adopts a new lane, or exceed its bytes, which geth does not limit. If so,
+// the empty payload is resolved instead so that the proposal remains valid.
+func (m *Miner) resolveEnvelope(
+	ctx sdk.Context, params evmtypes.Params, space evmtypes.BlockSpace, deadline time.Time,
+) ([]byte, uint64) {
 	if m.currentPayload == nil {
 		return nil, 0
 	}
-	envelope := m.currentPayload.ResolveFull()
+	envelope := m.resolveBest(ctx, deadline)
+	bz := m.serializeEnvelope(envelope, params.EnvelopeEncoding)
+	if err := space.VerifyEVM(evmtypes.TxsSize(bz), envelope.ExecutionPayload.GasUsed); err != nil {
+		ctx.Logger().Error("payload exceeds the evm block space, resolving empty payload", "err", err)
+		telemetry.IncrCounter(1, MetricKeyEmptyPayloads)
+		envelope = m.currentPayload.ResolveEmpty()
+		bz = m.serializeEnvelope(envelope, params.EnvelopeEncoding)
+	}
 	payload := envelope.ExecutionPayload
 
 	// Record metadata about the payload
 	defer telemetry.SetGauge(float32(payload.GasUsed), MetricKeyBlockGasUsed)
 	defer telemetry.SetGauge(float32(len(payload.Transactions)), MetricKeyTransactions)
 
-	bz, err := m.serializer.ToSdkTxBytes(envelope, payload.GasLimit)
+	return bz, payload.GasUsed
+}
+
+// buildDeadline returns the deadline for building the payload requested at the given context,
+// which is the build timeout after the block time of the request, or after the start of
+// building if the block time has already passed, as CometBFT's median block times have.
+func (m *Miner) buildDeadline(ctx sdk.Context, start time.Time) time.Time {
+	if blockTime := ctx.BlockTime(); blockTime.After(start) {
+		start = blockTime
+	}
+	return start.Add(m.buildTimeout)
+}
+
+// resolveBest resolves the full payload as soon as it is built, or the best payload built by the
+// deadline, which is the empty payload if none was built yet. Without a build timeout it waits
+// for the full payload.
+func (m *Miner) resolveBest(
+	ctx sdk.Context, deadline time.Time,
+) *engine.ExecutionPayloadEnvelope {
+	payload := m.currentPayload
+	if m.buildTimeout <= 0 {
+		return payload.ResolveFull()
+	}
+
+	// Geth does not wake the wait for the full payload if building stops without one, so it is
+	// left waiting if none is built by the deadline. The build timeout leaves room for the first
+	// full payload by staying above the new payload timeout of the miner.
+	full := make(chan *engine.ExecutionPayloadEnvelope, 1)
+	go func() { full <- payload.ResolveFull() }()
+
+	timer := time.NewTimer(time.Until(deadline))
+	defer timer.Stop()
+	select {
+	case envelope := <-full:
+		return envelope
+	case <-timer.C:
+	}
+
+	envelope := payload.Resolve()
+	telemetry.IncrCounter(1, MetricKeyBuildDeadlineHits)
+	ctx.Logger().Info("payload building hit the deadline", "empty",
+		envelope.ExecutionPayload.BlockHash == payload.ResolveEmpty().ExecutionPayload.BlockHash)
+	return envelope
+}
+
+// serializeEnvelope serializes the envelope in the given encoding into the bytes of a sdk
+// transaction.
+func (m *Miner) serializeEnvelope(
+	envelope *engine.ExecutionPayloadEnvelope, encoding evmtypes.EnvelopeEncoding,
+) []byte {
+	wrapped, err := evmtypes.WrapPayload(envelope, encoding)
 	if err != nil {
 		panic(err)
 	}
-
-	return bz, payload.GasUsed
+	bz, err := m.serializer.ToSdkTxBytes(wrapped, envelope.ExecutionPayload.GasLimit)
+	if err != nil {
+		panic(err)
+	}
+	return bz
 }
 
 // clearPayload clears the payload.
@@ -138,39 +260,106 @@ func (m *Miner) clearPayload() {
 	m.currentPayload = nil
 }
 
-// processValidatorMsgs processes the validator messages.
+// processValidatorMsgs processes the validator messages. Transactions are first selected
+// from the cosmos lane of the app-side mempool in priority order, after which any remaining
+// transactions provided by CometBFT are considered. The transactions are limited to the block
+// space left over by a payload envelope of the given bytes and gas.
 func (m *Miner) processValidatorMsgs(
-	ctx sdk.Context, maxTxBytes int64, ethGasUsed uint64, txs [][]byte,
-) ([][]byte, error) { //nolint:unparam // should be handled better.
-	var maxBlockGas uint64
-	if b := ctx.ConsensusParams().Block; b != nil {
-		maxBlockGas = uint64(b.MaxGas)
+	ctx sdk.Context, params evmtypes.Params, space evmtypes.BlockSpace,
+	envBytes, ethGasUsed uint64, txs [][]byte,
+) ([][]byte, error) {
+	maxTxBytes, blockGasRemaining := space.CosmosSpace(envBytes, ethGasUsed)
+	if maxTxBytes == 0 || blockGasRemaining == 0 {
+		// The selector treats a gas limit of zero as unlimited, so there must be no selection.
+		return nil, nil
 	}
+	if blockGasRemaining == math.MaxUint64 {
+		// The block gas is unlimited.
+		blockGasRemaining = 0
+	}
+
+	// Reset the selector once the proposal has been built.
+	defer m.valTxSelector.Clear()
 
-	blockGasRemaining := maxBlockGas - ethGasUsed
+	// seen tracks the transactions that have already been considered for the proposal.
+	seen := make(map[cmttypes.TxKey]struct{})
+
+	if m.mempool != nil {
+		full, err := m.selectMempoolTxs(ctx, params, maxTxBytes, blockGasRemaining, txs, seen)
+		if err != nil {
+			return nil, err
+		} else if full {
+			return m.valTxSelector.SelectedTxs(ctx), nil
+		}
+	}
 
 	for _, txBz := range txs {
+		if _, ok := seen[cmttypes.Tx(txBz).Key()]; ok {
+			continue
+		}
+
 		tx, err := m.app.TxDecode(txBz)
+		if err != nil || !isAllowedTx(params, tx) {
+			continue
+		}
+
+		if m.valTxSelector.SelectTxForProposal(
+			ctx, maxTxBytes, blockGasRemaining, tx, txBz,
+		) {
+			break
+		}
+	}
+	return m.valTxSelector.SelectedTxs(ctx), nil
+}
+
+// selectMempoolTxs selects the transactions of the mempool for the proposal, recording the
+// ones it considered in seen, and returns whether the proposal is full. Transactions that can
+// never be included in a proposal are evicted from the mempool once the iteration is done, as
+// the mempool must not be modified while it is being iterated.
+func (m *Miner) selectMempoolTxs(
+	ctx sdk.Context, params evmtypes.Params, maxTxBytes, blockGasRemaining uint64,
+	txs [][]byte, seen map[cmttypes.TxKey]struct{},
+) (bool, error) {
+	var (
+		full    bool
+		evicted []sdk.Tx
+	)
+	for iter := m.mempool.Select(ctx, txs); iter != nil; iter = iter.Next() {
+		tx := iter.Tx()
+		if !isAllowedTx(params, tx) {
+			evicted = append(evicted, tx)
+			continue
+		}
+
+		txBz, err := m.app.TxEncode(tx)
 		if err != nil {
 			continue
 		}
+		seen[cmttypes.Tx(txBz).Key()] = struct{}{}
 
-		includeTx := true
-		for _, msg := range tx.GetMsgs() {
-			if _, ok := m.allowedValMsgs[proto.MessageName(msg)]; !ok {
-				includeTx = false
-				break
-			}
+		if m.valTxSelector.SelectTxForProposal(
+			ctx, maxTxBytes, blockGasRemaining, tx, txBz,
+		) {
+			full = true
+			break
 		}
+	}
 
-		if includeTx {
-			stop := m.valTxSelector.SelectTxForProposal(
-				ctx, uint64(maxTxBytes), blockGasRemaining, tx, txBz,
-			)
-			if stop {
-				break
-			}
+	for _, tx := range evicted {
+		if err := m.mempool.Remove(tx); err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+			return false, err
 		}
 	}
-	return m.valTxSelector.SelectedTxs(ctx), nil
+	return full, nil
+}
+
+// isAllowedTx returns true if every message in the transaction is allowed to be
+// submitted by external users.
+func isAllowedTx(params evmtypes.Params, tx sdk.Tx) bool {
+	for _, msg := range tx.GetMsgs() {
+		if !params.IsAllowedValidatorMsg(proto.MessageName(msg)) {
+			return false
+		}
+	}
+	return true
 }
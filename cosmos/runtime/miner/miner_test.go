@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package miner
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestMiner(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/runtime/miner")
+}
+
+// mockPayload is a payload whose full payload is built once built is closed. Like geth's, waiting
+// for the full payload blocks until it is built.
+type mockPayload struct {
+	empty, full *engine.ExecutionPayloadEnvelope
+	built       chan struct{}
+}
+
+func (p *mockPayload) Resolve() *engine.ExecutionPayloadEnvelope {
+	select {
+	case <-p.built:
+		return p.full
+	default:
+		return p.empty
+	}
+}
+
+func (p *mockPayload) ResolveEmpty() *engine.ExecutionPayloadEnvelope { return p.empty }
+
+func (p *mockPayload) ResolveFull() *engine.ExecutionPayloadEnvelope {
+	<-p.built
+	return p.full
+}
+
+// newEnvelope returns an envelope of the payload with the given block hash.
+func newEnvelope(hash common.Hash) *engine.ExecutionPayloadEnvelope {
+	return &engine.ExecutionPayloadEnvelope{
+		ExecutionPayload: &engine.ExecutableData{BlockHash: hash},
+	}
+}
+
+var _ = Describe("resolveBest", func() {
+	const buildTimeout = time.Second
+
+	var (
+		ctx     sdk.Context
+		m       *Miner
+		payload *mockPayload
+	)
+
+	BeforeEach(func() {
+		ctx = sdk.Context{}.WithLogger(log.NewNopLogger())
+		payload = &mockPayload{
+			empty: newEnvelope(common.Hash{1}),
+			full:  newEnvelope(common.Hash{2}),
+			built: make(chan struct{}),
+		}
+		m = &Miner{currentPayload: payload, buildTimeout: buildTimeout}
+	})
+
+	It("should resolve the full payload as soon as it is built", func() {
+		start := time.Now()
+		time.AfterFunc(10*time.Millisecond, func() { close(payload.built) })
+		Expect(m.resolveBest(ctx, start.Add(buildTimeout))).To(Equal(payload.full))
+		Expect(time.Since(start)).To(BeNumerically("<", buildTimeout))
+	})
+
+	It("should resolve the empty payload if no full payload was built by the deadline", func() {
+		start := time.Now()
+		Expect(m.resolveBest(ctx, start.Add(20*time.Millisecond))).To(Equal(payload.empty))
+		Expect(time.Since(start)).To(BeNumerically(">=", 20*time.Millisecond))
+	})
+
+	It("should wait for the full payload without a build timeout", func() {
+		m.buildTimeout = 0
+		close(payload.built)
+		Expect(m.resolveBest(ctx, time.Now())).To(Equal(payload.full))
+	})
+})
+
+var _ = Describe("buildDeadline", func() {
+	const buildTimeout = time.Second
+
+	var (
+		m     = &Miner{buildTimeout: buildTimeout}
+		start = time.Now()
+	)
+
+	It("should count the build timeout from the start of building", func() {
+		ctx := sdk.Context{}.WithBlockTime(start.Add(-time.Minute))
+		Expect(m.buildDeadline(ctx, start)).To(BeTemporally("==", start.Add(buildTimeout)))
+	})
+
+	It("should count the build timeout from a block time after the start of building", func() {
+		ctx := sdk.Context{}.WithBlockTime(start.Add(time.Second))
+		Expect(m.buildDeadline(ctx, start)).
+			To(BeTemporally("==", start.Add(time.Second+buildTimeout)))
+	})
+})
@@ -21,7 +21,9 @@
 package miner
 
 const (
-	MetricKeyBuildBlock   = "polaris_miner_build_block"
-	MetricKeyBlockGasUsed = "polaris_miner_block_gas_used"
-	MetricKeyTransactions = "polaris_miner_transactions"
+	MetricKeyBuildBlock        = "polaris_miner_build_block"
+	MetricKeyBlockGasUsed      = "polaris_miner_block_gas_used"
+	MetricKeyTransactions      = "polaris_miner_transactions"
+	MetricKeyEmptyPayloads     = "polaris_miner_empty_payloads"
+	MetricKeyBuildDeadlineHits = "polaris_miner_build_deadline_hits"
 )
@@ -22,6 +22,8 @@ package runtime
 
 import (
 	"context"
+	"errors"
+	"path/filepath"
 	"time"
 
 	cosmoslog "cosmossdk.io/log"
@@ -46,11 +48,16 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
 
-	"github.com/ethereum/go-ethereum/beacon/engine"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	ethlog "github.com/ethereum/go-ethereum/log"
 )
 
+const (
+	// broadcastJournalFile is the name of the file that failed txpool broadcasts are journaled
+	// to, stored next to the legacy txpool journal.
+	broadcastJournalFile = "broadcasts.rlp"
+)
+
 // EVMKeeper is an interface that defines the methods needed for the EVM setup.
 type EVMKeeper interface {
 	// Setup initializes the EVM keeper.
@@ -58,6 +65,14 @@ type EVMKeeper interface {
 	GetStatePluginFactory() core.StatePluginFactory
 	SetLatestQueryContext(context.Context) error
 	GetHost() core.PolarisHostChain
+	GetParams(context.Context) evmtypes.Params
+	// VerifyChainConfig adopts the on-chain chain config and errors if the local chain config
+	// conflicts with it.
+	VerifyChainConfig(context.Context) error
+	// SetCommitHook sets a function that runs after every committed block.
+	SetCommitHook(func(context.Context))
+	// Close releases the node-local resources of the EVM keeper.
+	Close() error
 }
 
 // CosmosApp is an interface that defines the methods needed for the Cosmos setup.
@@ -67,6 +82,7 @@ type CosmosApp interface {
 	SetMempool(mempool.Mempool)
 	SetAnteHandler(sdk.AnteHandler)
 	TxDecode(txBz []byte) (sdk.Tx, error)
+	TxEncode(tx sdk.Tx) ([]byte, error)
 	CommitMultiStore() storetypes.CommitMultiStore
 	PreBlocker(sdk.Context, *cometabci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error)
 	BeginBlocker(ctx sdk.Context) (sdk.BeginBlock, error)
@@ -87,19 +103,38 @@ type Polaris struct {
 	WrappedBlockchain *chain.WrappedBlockchain
 	// logger is the underlying logger supplied by the sdk.
 	logger cosmoslog.Logger
+	// ek is the evm keeper that holds the on-chain chain config.
+	ek EVMKeeper
+	// gasCeil is the gas ceiling of the payloads built by the miner.
+	gasCeil uint64
+	// buildTimeout is the time budget of the miner for building a payload.
+	buildTimeout time.Duration
+
+	// stateLoaded is set once the last state is loaded, at loadedHeight.
+	stateLoaded  bool
+	loadedHeight int64
+
+	// stopServices aborts starting the services, started is closed with startErr set once they
+	// are started or failed to start.
+	stopServices context.CancelFunc
+	started      chan struct{}
+	startErr     error
 }
 
-// New creates a new Polaris runtime from the provided dependencies.
+// New creates a new Polaris runtime from the provided dependencies. The health and readiness
+// endpoints are served from here on, it returns an error if they cannot be.
 func New(
 	app CosmosApp,
 	cfg *eth.Config,
 	logger cosmoslog.Logger,
 	host core.PolarisHostChain,
 	engine consensus.Engine,
-) *Polaris {
+) (*Polaris, error) {
 	var err error
 	p := &Polaris{
-		logger: logger,
+		logger:       logger,
+		gasCeil:      cfg.Polar.Miner.GasCeil,
+		buildTimeout: cfg.Polar.MinerBuildTimeout,
 	}
 
 	ctx := sdk.Context{}.
@@ -117,32 +152,41 @@ func New(
 		ethlog.NewLogger(newEthHandler(logger)),
 	)
 	if err != nil {
-		panic(err)
+		return nil, err
+	}
+
+	// Serve the health and readiness endpoints, which report the execution layer as unavailable
+	// until it is ready.
+	if err = p.ExecutionLayer.ServeHealth(); err != nil {
+		return nil, err
 	}
 
 	p.WrappedTxPool = txpool.New(
 		p.ExecutionLayer.Backend().Blockchain(),
 		p.ExecutionLayer.Backend().TxPool(),
 		cfg.Polar.LegacyTxPool.Lifetime,
+		broadcastJournal(cfg.Polar.LegacyTxPool.Journal),
 	)
 
-	return p
+	return p, nil
 }
 
 // Build is a function that sets up the Polaris struct.
 // It takes a BaseApp and an EVMKeeper as arguments.
 // It returns an error if the setup fails.
 func (p *Polaris) Build(
-	app CosmosApp, cosmHandler sdk.AnteHandler, ek EVMKeeper, allowedValMsgs map[string]sdk.Msg,
+	app CosmosApp, cosmHandler sdk.AnteHandler, ek EVMKeeper,
 ) error {
+	p.ek = ek
+
 	// Wrap the geth miner and txpool with the cosmos miner and txpool.
 	p.WrappedMiner = miner.New(
-		p.ExecutionLayer.Backend().Miner(), app,
+		p.ExecutionLayer, app, p.WrappedTxPool,
 		ek.GetHost().GetStatePluginFactory(),
-		allowedValMsgs,
+		ek, p.gasCeil, p.buildTimeout,
 	)
 	p.WrappedBlockchain = chain.New(
-		p.ExecutionLayer.Backend().Blockchain(), app,
+		p.ExecutionLayer.Backend().Blockchain(), app, ek,
 	)
 
 	p.ProposalProvider = polarabci.NewProposalProvider(
@@ -157,20 +201,44 @@ func (p *Polaris) Build(
 	if err := ek.Setup(p.WrappedBlockchain); err != nil {
 		return err
 	}
+	ek.SetCommitHook(p.onCommit)
 
 	app.SetAnteHandler(
-		antelib.NewAnteHandler(p.WrappedTxPool, cosmHandler).AnteHandler(),
+		antelib.NewAnteHandler(
+			p.WrappedTxPool, p.WrappedBlockchain, ek, cosmHandler,
+		).AnteHandler(),
 	)
 
 	return nil
 }
 
+// SetRandaoProvider replaces the default CometBFT based source of the prevRandao of payloads.
+// The same provider is used to build proposals and to verify them, it must be called after
+// Build.
+func (p *Polaris) SetRandaoProvider(randao comet.RandaoProvider) {
+	p.WrappedMiner.SetRandaoProvider(randao)
+	p.WrappedBlockchain.SetRandaoProvider(randao)
+}
+
+// SetWithdrawalsProvider registers the host chain hook that injects EIP-4895 withdrawals (e.g.
+// reward payouts or completed unbondings) into every payload. The same provider is used to
+// build proposals and to verify them, it must be called after Build.
+func (p *Polaris) SetWithdrawalsProvider(withdrawals comet.WithdrawalsProvider) {
+	p.WrappedMiner.SetWithdrawalsProvider(withdrawals)
+	p.WrappedBlockchain.SetWithdrawalsProvider(withdrawals)
+}
+
 // SetupServices initializes and registers the services with Polaris.
 // It takes a client context as an argument and returns an error if the setup fails.
 func (p *Polaris) SetupServices(clientCtx client.Context) error {
-	// Initialize the miner with a new execution payload serializer.
-	p.WrappedMiner.Init(libtx.NewSerializer[*engine.ExecutionPayloadEnvelope](
-		clientCtx.TxConfig, evmtypes.WrapPayload))
+	// Initialize the miner with a new execution payload serializer. The miner wraps the
+	// payloads itself, in the encoding set by the params.
+	p.WrappedMiner.Init(libtx.NewSerializer[*evmtypes.WrappedPayloadEnvelope](
+		clientCtx.TxConfig,
+		func(wpe *evmtypes.WrappedPayloadEnvelope) (*evmtypes.WrappedPayloadEnvelope, error) {
+			return wpe, nil
+		},
+	))
 
 	// Initialize the txpool with a new transaction serializer.
 	p.WrappedTxPool.Init(p.logger, clientCtx, libtx.NewSerializer[*ethtypes.Transaction](
@@ -184,7 +252,7 @@ func (p *Polaris) SetupServices(clientCtx client.Context) error {
 	// Register the sync status provider with Polaris.
 	p.ExecutionLayer.Backend().RegisterSyncStatusProvider(comet.NewSyncProvider(clientCtx))
 
-	// Start the services. TODO: move to place race condition is solved.
+	// Start the services once the execution layer is ready to serve.
 	return p.StartServices()
 }
 
@@ -198,22 +266,66 @@ func (p *Polaris) RegisterLifecycles(lcs []node.Lifecycle) {
 	}
 }
 
-// StartServices starts the services of the Polaris struct.
+// StartServices starts the services of the Polaris struct in the background, as soon as the last
+// state is loaded and a block has been committed on top of it. Serving JSON-RPC before then would
+// answer queries against a state that is not yet committed. The outcome of starting the services
+// can be awaited with WaitForStart.
 func (p *Polaris) StartServices() error {
+	if !p.stateLoaded {
+		return errors.New("the last state must be loaded before starting services")
+	}
+	if p.started != nil {
+		return errors.New("services are already started")
+	}
+
+	var ctx context.Context
+	ctx, p.stopServices = context.WithCancel(context.Background())
+	p.started = make(chan struct{})
 	go func() {
-		// TODO: these values are sensitive due to a race condition in the json-rpc ports opening.
-		// If the JSON-RPC opens before the first block is committed, hive tests will start failing.
-		// This needs to be fixed before mainnet as its ghetto af. If the block time is too long
-		// and this sleep is too short, it will cause hive tests to error out.
-		time.Sleep(5 * time.Second) //nolint:gomnd // as explained above.
-		if err := p.ExecutionLayer.Start(); err != nil {
-			panic(err)
+		defer close(p.started)
+		if p.startErr = p.ExecutionLayer.StartWhenReady(ctx); p.startErr != nil {
+			p.logger.Error("failed to start execution layer", "err", p.startErr)
 		}
 	}()
 
 	return nil
 }
 
+// WaitForStart blocks until the services started by StartServices are serving, and returns the
+// error that prevented them from starting, if any.
+func (p *Polaris) WaitForStart(ctx context.Context) error {
+	if p.started == nil {
+		return errors.New("services are not started")
+	}
+	select {
+	case <-p.started:
+		return p.startErr
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// onCommit marks the execution layer as ready once a block is committed on top of the loaded
+// state.
+func (p *Polaris) onCommit(ctx context.Context) {
+	if p.stateLoaded && sdk.UnwrapSDKContext(ctx).BlockHeight() > p.loadedHeight {
+		p.Readiness().SetBlockCommitted()
+	}
+}
+
+// Close aborts starting the services, stops the execution layer and closes the node-local
+// resources of the EVM keeper.
+func (p *Polaris) Close() error {
+	if p.stopServices != nil {
+		p.stopServices()
+	}
+	err := p.ExecutionLayer.Close()
+	if p.ek != nil {
+		err = errors.Join(err, p.ek.Close())
+	}
+	return err
+}
+
 // LoadLastState is a function that loads the last state of the Polaris struct.
 // It takes a CommitMultiStore and an appHeight as arguments.
 // It returns an error if the loading fails.
@@ -224,5 +336,23 @@ func (p *Polaris) LoadLastState(cms storetypes.CommitMultiStore, appHeight uint6
 		WithBlockHeight(int64(appHeight)).
 		WithGasMeter(storetypes.NewInfiniteGasMeter()).
 		WithBlockGasMeter(storetypes.NewInfiniteGasMeter()).WithEventManager(sdk.NewEventManager())
-	return p.Backend().Blockchain().LoadLastState(cmsCtx, appHeight)
+	if err := p.ek.VerifyChainConfig(cmsCtx); err != nil {
+		return err
+	}
+	if err := p.Backend().Blockchain().LoadLastState(cmsCtx, appHeight); err != nil {
+		return err
+	}
+
+	p.stateLoaded, p.loadedHeight = true, int64(appHeight)
+	p.Readiness().SetStateLoaded()
+	return nil
+}
+
+// broadcastJournal returns the path of the broadcast retry journal for the given legacy txpool
+// journal, or an empty path if journaling is disabled.
+func broadcastJournal(txJournal string) string {
+	if txJournal == "" {
+		return ""
+	}
+	return filepath.Join(filepath.Dir(txJournal), broadcastJournalFile)
 }
@@ -25,12 +25,15 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/hashicorp/go-metrics"
+
 	"cosmossdk.io/log"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/event"
@@ -40,8 +43,20 @@ import (
 // size of tx pool.
 const (
 	txChanSize = 4096
-	maxRetries = 5
-	retryDelay = 50 * time.Millisecond
+
+	// maxRetries is the number of times a failed broadcast is retried before it is dropped.
+	maxRetries = 10
+	// baseRetryDelay and maxRetryDelay bound the exponential backoff between retries.
+	baseRetryDelay = 500 * time.Millisecond
+	maxRetryDelay  = 30 * time.Second
+	// retryInterval is how often the retry journal is polled for transactions that are due.
+	retryInterval = 100 * time.Millisecond
+	// rejournalInterval is how often the retry journal is compacted on disk.
+	rejournalInterval = 10 * time.Second
+
+	// retryJournalLimit and retryJournalSenderLimit bound the size of the retry journal.
+	retryJournalLimit       = 4096
+	retryJournalSenderLimit = 64
 )
 
 // SdkTx is used to generate mocks.
@@ -52,6 +67,8 @@ type SdkTx interface {
 // TxSubProvider.
 type TxSubProvider interface {
 	SubscribeTransactions(ch chan<- core.NewTxsEvent, reorgs bool) event.Subscription
+	Has(hash common.Hash) bool
+	Get(hash common.Hash) *ethtypes.Transaction
 }
 
 // TxSerializer provides an interface to Serialize Geth Transactions to Bytes (via sdk.Tx).
@@ -70,12 +87,6 @@ type Subscription interface {
 	event.Subscription
 }
 
-// failedTx represents a transaction that failed to broadcast.
-type failedTx struct {
-	tx      *ethtypes.Transaction
-	retries int
-}
-
 // handler listens for new insertions into the geth txpool and broadcasts them to the CometBFT
 // layer for p2p and ABCI.
 type handler struct {
@@ -91,13 +102,15 @@ type handler struct {
 	txsSub  Subscription
 	running atomic.Bool
 
-	// Queue for failed transactions
-	failedTxs chan *failedTx
+	// Journal of failed transactions
+	retries *retryJournal
 }
 
-// newHandler creates a new handler.
+// newHandler creates a new handler. Failed broadcasts are persisted at the given journal path,
+// or only kept in memory if it is empty.
 func newHandler(
 	clientCtx TxBroadcaster, txPool TxSubProvider, serializer TxSerializer, logger log.Logger,
+	journal string,
 ) *handler {
 	h := &handler{
 		logger:     logger,
@@ -106,7 +119,7 @@ func newHandler(
 		txPool:     txPool,
 		txsCh:      make(chan core.NewTxsEvent, txChanSize),
 		stopCh:     make(chan struct{}),
-		failedTxs:  make(chan *failedTx, txChanSize),
+		retries:    newRetryJournal(journal, retryJournalLimit, retryJournalSenderLimit),
 	}
 	return h
 }
@@ -116,6 +129,11 @@ func (h *handler) Start() error {
 	if h.running.Load() {
 		return errors.New("handler already started")
 	}
+	if loaded, err := h.retries.load(time.Now()); err != nil {
+		h.logger.Error("failed to load broadcast retry journal", "err", err)
+	} else if loaded > 0 {
+		h.logger.Info("loaded broadcast retry journal", "num_txs", loaded)
+	}
 	go h.mainLoop()
 	go h.failedLoop() // Start the retry policy
 	return nil
@@ -154,22 +172,39 @@ func (h *handler) mainLoop() {
 	}
 }
 
-// failedLoop will periodically attempt to re-broadcast failed transactions.
+// failedLoop will periodically attempt to re-broadcast failed transactions that are due.
 func (h *handler) failedLoop() {
+	retry := time.NewTicker(retryInterval)
+	defer retry.Stop()
+	rejournal := time.NewTicker(rejournalInterval)
+	defer rejournal.Stop()
+
 	for {
 		select {
 		case <-h.stopCh:
+			if err := h.retries.close(); err != nil {
+				h.logger.Error("failed to close broadcast retry journal", "err", err)
+			}
 			return
-		case failed := <-h.failedTxs:
-			if failed.retries == 0 {
-				h.logger.Error("failed to broadcast transaction after max retries", "tx", maxRetries)
-				continue
+		case <-retry.C:
+			for {
+				failed := h.retries.next(time.Now())
+				if failed == nil {
+					break
+				}
+				// Transactions that have left the txpool were either included or replaced.
+				if !h.txPool.Has(failed.tx.Hash()) {
+					h.dropRetry(failed.tx, dropReasonStale)
+					continue
+				}
+				h.broadcastTransaction(failed.tx, failed.attempts+1)
+			}
+			telemetry.SetGauge(float32(h.retries.len()), MetricKeyRetryJournalSize)
+		case <-rejournal.C:
+			if err := h.retries.rotate(); err != nil {
+				h.logger.Error("failed to rotate broadcast retry journal", "err", err)
 			}
-			h.broadcastTransaction(failed.tx, failed.retries-1)
 		}
-
-		// We slightly space out the retries in order to prioritize new transactions.
-		time.Sleep(retryDelay)
 	}
 }
 
@@ -194,20 +229,30 @@ func (h *handler) stop(err error) {
 	// Close channels.
 	close(h.txsCh)
 	close(h.stopCh)
-	close(h.failedTxs)
 }
 
 // broadcastTransactions will propagate a batch of transactions to the CometBFT mempool.
 func (h *handler) broadcastTransactions(txs ethtypes.Transactions) {
 	h.logger.Debug("broadcasting transactions", "num_txs", len(txs))
 	for _, signedEthTx := range txs {
-		h.broadcastTransaction(signedEthTx, maxRetries)
+		h.broadcastTransaction(signedEthTx, 0)
 	}
 }
 
-// broadcastTransaction will propagate a transaction to the CometBFT mempool.
-func (h *handler) broadcastTransaction(tx *ethtypes.Transaction, retries int) {
-	txBytes, err := h.serializer.ToSdkTxBytes(tx, tx.Gas())
+// broadcastTransaction will propagate a transaction to the CometBFT mempool. The number of
+// previous attempts is used to schedule a retry should the broadcast fail.
+func (h *handler) broadcastTransaction(tx *ethtypes.Transaction, attempts uint64) {
+	// The txpool announces blob transactions without their sidecar, so the full transaction
+	// has to be resolved in order for the blobs to be propagated to CometBFT.
+	full := tx
+	if tx.Type() == ethtypes.BlobTxType && tx.BlobTxSidecar() == nil {
+		if full = h.txPool.Get(tx.Hash()); full == nil {
+			h.dropRetry(tx, dropReasonStale)
+			return
+		}
+	}
+
+	txBytes, err := h.serializer.ToSdkTxBytes(full, full.Gas())
 	if err != nil {
 		h.logger.Error("failed to serialize transaction", "err", err)
 		return
@@ -219,7 +264,7 @@ func (h *handler) broadcastTransaction(tx *ethtypes.Transaction, retries int) {
 
 	if err != nil {
 		h.logger.Error("error on transactions broadcast", "err", err)
-		h.failedTxs <- &failedTx{tx: tx, retries: retries}
+		h.retryTransaction(tx, attempts)
 		return
 	}
 
@@ -240,5 +285,27 @@ func (h *handler) broadcastTransaction(tx *ethtypes.Transaction, retries int) {
 		telemetry.IncrCounter(float32(1), MetricKeyBroadcastFailure)
 	}
 
-	h.failedTxs <- &failedTx{tx: tx, retries: retries}
+	h.retryTransaction(tx, attempts)
+}
+
+// retryTransaction queues a transaction in the retry journal, without ever blocking the caller.
+func (h *handler) retryTransaction(tx *ethtypes.Transaction, attempts uint64) {
+	if attempts >= maxRetries {
+		h.dropRetry(tx, dropReasonMaxRetries)
+		return
+	}
+	if reason := h.retries.add(tx, attempts, time.Now()); reason != "" {
+		h.dropRetry(tx, reason)
+		return
+	}
+	telemetry.IncrCounter(float32(1), MetricKeyRetryQueued)
+}
+
+// dropRetry gives up on re-broadcasting a transaction for the given reason.
+func (h *handler) dropRetry(tx *ethtypes.Transaction, reason string) {
+	h.logger.Error("dropping failed transaction broadcast", "reason", reason, "tx_hash", tx.Hash())
+	telemetry.IncrCounterWithLabels(
+		[]string{MetricKeyRetryDropped}, float32(1),
+		[]metrics.Label{telemetry.NewLabel("reason", reason)},
+	)
 }
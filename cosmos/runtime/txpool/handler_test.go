@@ -21,6 +21,7 @@
 package txpool
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -60,7 +61,9 @@ var _ = Describe("", func() {
 		subprovider = mocks.NewTxSubProvider(t)
 		subprovider.On("SubscribeTransactions", mock.Anything, mock.Anything).Return(subscription)
 		serializer = mocks.NewTxSerializer(t)
-		h = newHandler(broadcaster, subprovider, serializer, log.NewTestLogger(t))
+		h = newHandler(
+			broadcaster, subprovider, serializer, log.NewTestLogger(t), "",
+		)
 		err := h.Start()
 		Expect(err).NotTo(HaveOccurred())
 		for !h.Running() {
@@ -101,6 +104,35 @@ var _ = Describe("", func() {
 				ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: 6, Gas: 10}),
 			}}
 		})
+
+		It("should resolve the sidecar of blob txs", func() {
+			defer GinkgoRecover()
+			announced := ethtypes.NewTx(&ethtypes.BlobTx{Nonce: 5, Gas: 100})
+			full := ethtypes.NewTx(&ethtypes.BlobTx{
+				Nonce: 5, Gas: 100, Sidecar: &ethtypes.BlobTxSidecar{},
+			})
+			subprovider.On("Get", announced.Hash()).Return(full).Once()
+			serializer.On("ToSdkTxBytes", full, full.Gas()).Return([]byte{123}, nil).Once()
+			broadcaster.On("BroadcastTxSync", []byte{123}).Return(nil, nil).Once()
+
+			h.txsCh <- core.NewTxsEvent{Txs: []*ethtypes.Transaction{announced}}
+		})
+
+		It("should queue failed broadcasts for a retry", func() {
+			defer GinkgoRecover()
+			serializer.On("ToSdkTxBytes", mock.Anything, mock.Anything).Return([]byte{123}, nil).Once()
+			broadcaster.On("BroadcastTxSync", []byte{123}).
+				Return(nil, errors.New("comet unavailable")).Once()
+			subprovider.On("Has", mock.Anything).Return(false).Maybe()
+
+			h.txsCh <- core.NewTxsEvent{
+				Txs: []*ethtypes.Transaction{ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: 5, Gas: 100})},
+			}
+			Eventually(h.retries.len).Should(Equal(1))
+
+			// The transaction has left the txpool by the time it is due, so it is dropped.
+			Eventually(h.retries.len, 2*time.Second).Should(BeZero())
+		})
 	})
 
 })
@@ -0,0 +1,322 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package txpool
+
+import (
+	"errors"
+	"io"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// Reasons for which a transaction can be dropped from the retry journal.
+const (
+	dropReasonMaxRetries  = "max_retries"
+	dropReasonJournalFull = "journal_full"
+	dropReasonSenderLimit = "sender_limit"
+	dropReasonStale       = "stale"
+)
+
+// retryEntry is a transaction waiting to be re-broadcast to CometBFT.
+type retryEntry struct {
+	tx       *ethtypes.Transaction
+	sender   common.Address
+	attempts uint64
+	next     time.Time
+}
+
+// journalRecord is the on-disk representation of a retryEntry.
+type journalRecord struct {
+	Tx       *ethtypes.Transaction
+	Attempts uint64
+}
+
+// retryJournal is a bounded queue of transactions that failed to broadcast, backed by an
+// append-only RLP file so that pending retries survive a node restart.
+//
+// Transactions are deduplicated by hash and bucketed by sender. Each sender's bucket is kept in
+// nonce order and only the head of a bucket is eligible for a retry, while buckets themselves are
+// served round-robin so that a single busy account cannot starve everybody else. Removals are
+// only persisted when the journal is rotated; a crash in between results in a harmless
+// re-broadcast of an already handled transaction.
+type retryJournal struct {
+	mu sync.Mutex
+
+	path   string         // filesystem path to store the entries at, empty for memory only
+	writer io.WriteCloser // output stream to write new entries into
+
+	limit       int // maximum number of entries in the journal
+	senderLimit int // maximum number of entries per sender
+
+	entries map[common.Hash]*retryEntry
+	queues  map[common.Address][]*retryEntry
+	senders []common.Address // round-robin order of senders with queued entries
+	cursor  int
+	dirty   bool // whether entries were removed since the last rotation
+}
+
+// newRetryJournal creates a new retry journal persisted at the given path.
+func newRetryJournal(path string, limit, senderLimit int) *retryJournal {
+	return &retryJournal{
+		path:        path,
+		limit:       limit,
+		senderLimit: senderLimit,
+		entries:     make(map[common.Hash]*retryEntry),
+		queues:      make(map[common.Address][]*retryEntry),
+	}
+}
+
+// load reads the entries persisted on disk into the journal, making them immediately eligible
+// for a retry, and compacts the file. A missing file is not an error.
+func (j *retryJournal) load(now time.Time) (int, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.path == "" {
+		return 0, nil
+	}
+
+	input, err := os.Open(j.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, j.rotateLocked()
+	}
+	if err != nil {
+		return 0, err
+	}
+	defer input.Close()
+
+	// Later records for the same hash override earlier ones, as the journal is append-only.
+	var (
+		stream  = rlp.NewStream(input, 0)
+		records = make(map[common.Hash]*journalRecord)
+		order   []common.Hash
+		loadErr error
+	)
+	for {
+		record := new(journalRecord)
+		if err = stream.Decode(record); err != nil {
+			if !errors.Is(err, io.EOF) {
+				loadErr = err
+			}
+			break
+		}
+		hash := record.Tx.Hash()
+		if _, ok := records[hash]; !ok {
+			order = append(order, hash)
+		}
+		records[hash] = record
+	}
+
+	loaded := 0
+	for _, hash := range order {
+		record := records[hash]
+		if j.insertLocked(record.Tx, record.Attempts, now) == "" {
+			loaded++
+		}
+	}
+
+	if err = j.rotateLocked(); err != nil {
+		return loaded, err
+	}
+	return loaded, loadErr
+}
+
+// add queues a transaction for a retry after the backoff for the given attempt has elapsed. It
+// returns the reason for which the transaction was dropped, or an empty string if it was queued.
+// Transactions that are already queued are ignored.
+func (j *retryJournal) add(tx *ethtypes.Transaction, attempts uint64, now time.Time) string {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if _, ok := j.entries[tx.Hash()]; ok {
+		return ""
+	}
+	if reason := j.insertLocked(tx, attempts, now.Add(retryBackoff(attempts))); reason != "" {
+		return reason
+	}
+	if j.writer != nil {
+		// A failed append only loses crash safety for this entry, it is still queued in memory
+		// and will be persisted on the next rotation.
+		_ = rlp.Encode(j.writer, &journalRecord{Tx: tx, Attempts: attempts})
+	}
+	return ""
+}
+
+// next pops the next transaction that is due for a retry, or nil if there is none.
+func (j *retryJournal) next(now time.Time) *retryEntry {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	for i := 0; i < len(j.senders); i++ {
+		idx := (j.cursor + i) % len(j.senders)
+		sender := j.senders[idx]
+		queue := j.queues[sender]
+		if head := queue[0]; !head.next.After(now) {
+			j.removeLocked(sender, idx)
+			return head
+		}
+	}
+	return nil
+}
+
+// len returns the number of queued transactions.
+func (j *retryJournal) len() int {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return len(j.entries)
+}
+
+// rotate regenerates the journal file from the queued entries if any were removed since the
+// last rotation.
+func (j *retryJournal) rotate() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if !j.dirty {
+		return nil
+	}
+	return j.rotateLocked()
+}
+
+// close flushes the queued entries to disk and closes the journal file.
+func (j *retryJournal) close() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.writer == nil {
+		return nil
+	}
+	if err := j.rotateLocked(); err != nil {
+		return err
+	}
+	err := j.writer.Close()
+	j.writer = nil
+	return err
+}
+
+// insertLocked places a transaction into its sender's queue in nonce order.
+func (j *retryJournal) insertLocked(
+	tx *ethtypes.Transaction, attempts uint64, next time.Time,
+) string {
+	if len(j.entries) >= j.limit {
+		return dropReasonJournalFull
+	}
+
+	// Transactions with an unrecoverable sender all share the zero address bucket.
+	sender, _ := ethtypes.Sender(ethtypes.LatestSignerForChainID(tx.ChainId()), tx)
+	queue := j.queues[sender]
+	if len(queue) >= j.senderLimit {
+		return dropReasonSenderLimit
+	}
+
+	entry := &retryEntry{tx: tx, sender: sender, attempts: attempts, next: next}
+	pos := sort.Search(len(queue), func(i int) bool {
+		return queue[i].tx.Nonce() > tx.Nonce()
+	})
+	queue = append(queue, nil)
+	copy(queue[pos+1:], queue[pos:])
+	queue[pos] = entry
+
+	if len(queue) == 1 {
+		j.senders = append(j.senders, sender)
+	}
+	j.queues[sender] = queue
+	j.entries[tx.Hash()] = entry
+	return ""
+}
+
+// removeLocked pops the head of the queue of the sender at the given round-robin index and
+// advances the cursor past it.
+func (j *retryJournal) removeLocked(sender common.Address, idx int) {
+	queue := j.queues[sender]
+	delete(j.entries, queue[0].tx.Hash())
+	j.dirty = true
+
+	if len(queue) > 1 {
+		j.queues[sender] = queue[1:]
+		j.cursor = idx + 1
+		return
+	}
+
+	delete(j.queues, sender)
+	j.senders = append(j.senders[:idx], j.senders[idx+1:]...)
+	j.cursor = idx
+	if len(j.senders) == 0 {
+		j.cursor = 0
+	}
+}
+
+// rotateLocked atomically replaces the journal file with the currently queued entries.
+func (j *retryJournal) rotateLocked() error {
+	if j.path == "" {
+		j.dirty = false
+		return nil
+	}
+	if j.writer != nil {
+		if err := j.writer.Close(); err != nil {
+			return err
+		}
+		j.writer = nil
+	}
+
+	replacement, err := os.OpenFile(j.path+".new", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	for _, sender := range j.senders {
+		for _, entry := range j.queues[sender] {
+			record := &journalRecord{Tx: entry.tx, Attempts: entry.attempts}
+			if err = rlp.Encode(replacement, record); err != nil {
+				replacement.Close()
+				return err
+			}
+		}
+	}
+	replacement.Close()
+
+	if err = os.Rename(j.path+".new", j.path); err != nil {
+		return err
+	}
+	sink, err := os.OpenFile(j.path, os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	j.writer = sink
+	j.dirty = false
+	return nil
+}
+
+// retryBackoff returns the exponential delay before the given retry attempt.
+func retryBackoff(attempts uint64) time.Duration {
+	if attempts >= 32 {
+		return maxRetryDelay
+	}
+	if delay := baseRetryDelay << attempts; delay > 0 && delay < maxRetryDelay {
+		return delay
+	}
+	return maxRetryDelay
+}
@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package txpool
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"path/filepath"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("retryJournal", func() {
+	var (
+		j          *retryJournal
+		path       string
+		now        time.Time
+		alice, bob *ecdsa.PrivateKey
+	)
+
+	signedTx := func(key *ecdsa.PrivateKey, nonce uint64) *ethtypes.Transaction {
+		tx, err := ethtypes.SignNewTx(
+			key, ethtypes.LatestSignerForChainID(big.NewInt(1)),
+			&ethtypes.DynamicFeeTx{ChainID: big.NewInt(1), Nonce: nonce, Gas: 21000},
+		)
+		Expect(err).NotTo(HaveOccurred())
+		return tx
+	}
+
+	BeforeEach(func() {
+		var err error
+		alice, err = crypto.GenerateKey()
+		Expect(err).NotTo(HaveOccurred())
+		bob, err = crypto.GenerateKey()
+		Expect(err).NotTo(HaveOccurred())
+
+		path = filepath.Join(GinkgoT().TempDir(), "broadcasts.rlp")
+		now = time.Now()
+		j = newRetryJournal(path, 8, 4)
+		Expect(j.load(now)).To(BeZero())
+	})
+
+	AfterEach(func() {
+		Expect(j.close()).To(Succeed())
+	})
+
+	It("should deduplicate by hash", func() {
+		tx := signedTx(alice, 0)
+		Expect(j.add(tx, 0, now)).To(BeEmpty())
+		Expect(j.add(tx, 3, now)).To(BeEmpty())
+		Expect(j.len()).To(Equal(1))
+	})
+
+	It("should back off exponentially", func() {
+		Expect(j.add(signedTx(alice, 0), 2, now)).To(BeEmpty())
+		Expect(j.next(now.Add(4*baseRetryDelay - time.Millisecond))).To(BeNil())
+		Expect(j.next(now.Add(4 * baseRetryDelay))).NotTo(BeNil())
+		Expect(retryBackoff(64)).To(Equal(maxRetryDelay))
+	})
+
+	It("should serve senders round-robin in nonce order", func() {
+		Expect(j.add(signedTx(alice, 1), 0, now)).To(BeEmpty())
+		Expect(j.add(signedTx(alice, 0), 0, now)).To(BeEmpty())
+		Expect(j.add(signedTx(alice, 2), 0, now)).To(BeEmpty())
+		Expect(j.add(signedTx(bob, 0), 0, now)).To(BeEmpty())
+
+		due := now.Add(maxRetryDelay)
+		bobAddr := crypto.PubkeyToAddress(bob.PublicKey)
+		aliceAddr := crypto.PubkeyToAddress(alice.PublicKey)
+		expected := []struct {
+			sender common.Address
+			nonce  uint64
+		}{{aliceAddr, 0}, {bobAddr, 0}, {aliceAddr, 1}, {aliceAddr, 2}}
+		for _, exp := range expected {
+			entry := j.next(due)
+			Expect(entry).NotTo(BeNil())
+			Expect(entry.sender).To(Equal(exp.sender))
+			Expect(entry.tx.Nonce()).To(Equal(exp.nonce))
+		}
+		Expect(j.next(due)).To(BeNil())
+	})
+
+	It("should enforce the journal and sender limits", func() {
+		for i := uint64(0); i < 4; i++ {
+			Expect(j.add(signedTx(alice, i), 0, now)).To(BeEmpty())
+		}
+		Expect(j.add(signedTx(alice, 4), 0, now)).To(Equal(dropReasonSenderLimit))
+		for i := uint64(0); i < 4; i++ {
+			Expect(j.add(signedTx(bob, i), 0, now)).To(BeEmpty())
+		}
+		carol, err := crypto.GenerateKey()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(j.add(signedTx(carol, 0), 0, now)).To(Equal(dropReasonJournalFull))
+	})
+
+	It("should survive a restart", func() {
+		Expect(j.add(signedTx(alice, 0), 1, now)).To(BeEmpty())
+		Expect(j.add(signedTx(bob, 0), 0, now)).To(BeEmpty())
+		Expect(j.add(signedTx(bob, 1), 0, now)).To(BeEmpty())
+
+		// Popped entries are no longer persisted once the journal is rotated.
+		Expect(j.next(now.Add(maxRetryDelay))).NotTo(BeNil())
+		Expect(j.rotate()).To(Succeed())
+		Expect(j.close()).To(Succeed())
+
+		j = newRetryJournal(path, 8, 4)
+		Expect(j.load(now)).To(Equal(2))
+		entry := j.next(now)
+		Expect(entry).NotTo(BeNil())
+		Expect(entry.sender).To(Equal(crypto.PubkeyToAddress(bob.PublicKey)))
+		Expect(entry.tx.Nonce()).To(BeZero())
+	})
+})
@@ -34,6 +34,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 
 	ethtxpool "github.com/ethereum/go-ethereum/core/txpool"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -56,23 +57,38 @@ type GethTxPool interface {
 	eth.TxPool
 }
 
-// Mempool is a mempool that adheres to the cosmos mempool interface.
-// It purposefully does not implement `Select` or `Remove` as the purpose of this mempool
-// is to allow for transactions coming in from CometBFT's gossip to be added to the underlying
-// geth txpool during `CheckTx`, that is the only purpose of `Mempool“.
+// defaultCosmosLaneMaxTxs is the maximum number of cosmos transactions that can be held
+// in the cosmos lane of the mempool at any given time.
+const defaultCosmosLaneMaxTxs = 4096
+
+// Mempool is a dual-lane mempool that adheres to the cosmos mempool interface.
+//
+// Ethereum transactions coming in from CometBFT's gossip are added to the underlying geth
+// txpool during `CheckTx`, where they are picked up by the geth miner when building a payload.
+// All other (cosmos) transactions are placed into a priority-ordered cosmos lane, which is
+// exposed via `Select` and drained via `Remove` as transactions get included in blocks.
 type Mempool struct {
-	txpool   eth.TxPool
-	lifetime time.Duration
-	chain    core.ChainReader
-	handler  Lifecycle
+	txpool     eth.TxPool
+	cosmosLane mempool.Mempool
+	lifetime   time.Duration
+	chain      core.ChainReader
+	journal    string
+	handler    Lifecycle
 }
 
-// New creates a new Mempool.
-func New(chain core.ChainReader, txpool eth.TxPool, lifetime time.Duration) *Mempool {
+// New creates a new Mempool. Transactions that fail to broadcast to CometBFT are journaled at
+// the given path, which may be left empty to keep them in memory only.
+func New(
+	chain core.ChainReader, txpool eth.TxPool, lifetime time.Duration, journal string,
+) *Mempool {
+	cfg := mempool.DefaultPriorityNonceMempoolConfig()
+	cfg.MaxTx = defaultCosmosLaneMaxTxs
 	return &Mempool{
-		txpool:   txpool,
-		chain:    chain,
-		lifetime: lifetime,
+		txpool:     txpool,
+		cosmosLane: mempool.NewPriorityMempool(cfg),
+		chain:      chain,
+		lifetime:   lifetime,
+		journal:    journal,
 	}
 }
 
@@ -82,7 +98,7 @@ func (m *Mempool) Init(
 	txBroadcaster TxBroadcaster,
 	txSerializer TxSerializer,
 ) {
-	m.handler = newHandler(txBroadcaster, m.txpool, txSerializer, logger)
+	m.handler = newHandler(txBroadcaster, m.txpool, txSerializer, logger, m.journal)
 }
 
 // Start starts the Mempool TxHandler.
@@ -96,42 +112,77 @@ func (m *Mempool) Stop() error {
 }
 
 // Insert attempts to insert a Tx into the app-side mempool returning
-// an error upon failure.
+// an error upon failure. Ethereum transactions are routed to the geth txpool, while all
+// other transactions are routed to the cosmos lane.
 func (m *Mempool) Insert(ctx context.Context, sdkTx sdk.Tx) error {
-	sCtx := sdk.UnwrapSDKContext(ctx)
 	msgs := sdkTx.GetMsgs()
-	if len(msgs) != 1 {
-		return errors.New("only one message is supported")
+	if len(msgs) == 0 {
+		return errors.New("transaction contains no messages")
+	}
+
+	// Payload envelopes are placed in a block by its proposer only, never through the mempool.
+	if types.ContainsPayloadEnvelope(msgs) {
+		return types.ErrEnvelopeNotAllowed
+	}
+
+	if wet, ok := utils.GetAs[*types.WrappedEthereumTransaction](msgs[0]); ok {
+		if len(msgs) != 1 {
+			return errors.New("only one message is supported")
+		}
+		return m.insertEthTx(sdk.UnwrapSDKContext(ctx), wet)
 	}
 
-	if wet, ok := utils.GetAs[*types.WrappedEthereumTransaction](msgs[0]); !ok {
-		// We have to return nil for non-ethereum transactions as to not fail check-tx.
-		return nil
-	} else if errs := m.txpool.Add(
+	// The cosmos lane orders transactions by sender and nonce, thus it requires the
+	// transaction to be signed.
+	if _, ok := sdkTx.(signing.SigVerifiableTx); !ok {
+		return errors.New("cosmos transaction must be signed")
+	}
+	return m.cosmosLane.Insert(ctx, sdkTx)
+}
+
+// insertEthTx inserts an ethereum transaction into the geth txpool.
+func (m *Mempool) insertEthTx(ctx sdk.Context, wet *types.WrappedEthereumTransaction) error {
+	if errs := m.txpool.Add(
 		[]*ethtypes.Transaction{wet.Unwrap()}, false, false,
-	); len(errs) != 0 {
+	); len(errs) != 0 && errs[0] != nil {
 		// Handle case where a node broadcasts to itself, we don't want it to fail CheckTx.
-		if errors.Is(errs[0], ethtxpool.ErrAlreadyKnown) && sCtx.ExecMode() == sdk.ExecModeCheck {
+		if errors.Is(errs[0], ethtxpool.ErrAlreadyKnown) && ctx.ExecMode() == sdk.ExecModeCheck {
 			return nil
 		}
 		return errs[0]
 	}
-
 	return nil
 }
 
-// CountTx returns the number of transactions currently in the mempool.
+// CountTx returns the number of transactions currently in the mempool, across both lanes.
 func (m *Mempool) CountTx() int {
 	runnable, blocked := m.txpool.Stats()
-	return runnable + blocked
+	return runnable + blocked + m.cosmosLane.CountTx()
 }
 
-// Select is an intentional no-op as we use a custom prepare proposal.
-func (m *Mempool) Select(context.Context, [][]byte) mempool.Iterator {
-	return nil
+// Select returns an iterator over the cosmos lane, ordered by priority. Ethereum
+// transactions are not part of the iterator as they are selected by the geth miner
+// when building a payload.
+func (m *Mempool) Select(ctx context.Context, txs [][]byte) mempool.Iterator {
+	return m.cosmosLane.Select(ctx, txs)
 }
 
-// Remove is an intentional no-op as the eth txpool handles removals.
-func (m *Mempool) Remove(sdk.Tx) error {
-	return nil
+// Remove removes a transaction from the cosmos lane. Removing an ethereum transaction is
+// an intentional no-op as the eth txpool handles removals upon block inclusion.
+func (m *Mempool) Remove(sdkTx sdk.Tx) error {
+	msgs := sdkTx.GetMsgs()
+	if len(msgs) == 1 {
+		switch msgs[0].(type) {
+		case *types.WrappedEthereumTransaction, *types.WrappedPayloadEnvelope:
+			return nil
+		}
+	}
+
+	// Unsigned transactions can never be part of the cosmos lane.
+	if sigTx, ok := sdkTx.(signing.SigVerifiableTx); !ok {
+		return mempool.ErrTxNotFound
+	} else if sigs, err := sigTx.GetSignaturesV2(); err != nil || len(sigs) == 0 {
+		return mempool.ErrTxNotFound
+	}
+	return m.cosmosLane.Remove(sdkTx)
 }
@@ -24,11 +24,17 @@ import (
 	"errors"
 
 	"github.com/stretchr/testify/mock"
+	protov2 "google.golang.org/protobuf/proto"
 
 	"github.com/berachain/polaris/cosmos/runtime/txpool/mocks"
 	evmtypes "github.com/berachain/polaris/cosmos/x/evm/types"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
@@ -50,7 +56,7 @@ var _ = Describe("", func() {
 	BeforeEach(func() {
 		txPool = mocks.NewGethTxPool(t)
 		sdkTx = mocks.NewSdkTx(t)
-		mempool = &Mempool{txpool: txPool}
+		mempool = New(nil, txPool, 0, "")
 		wet, _ = evmtypes.WrapTx(ethtypes.NewTx(&ethtypes.LegacyTx{}))
 	})
 
@@ -81,14 +87,61 @@ var _ = Describe("", func() {
 				Expect(mempool.Insert(ctx, sdkTx)).To(HaveOccurred())
 			})
 		})
-		When("we use an that is not an ethereum msg", func() {
-			It("does not error", func() {
+		When("we use a payload envelope", func() {
+			It("errors", func() {
+				sdkTx.On("GetMsgs").Return([]sdk.Msg{&evmtypes.WrappedPayloadEnvelope{}}).Once()
+				Expect(mempool.Insert(ctx, sdkTx)).To(MatchError(evmtypes.ErrEnvelopeNotAllowed))
+			})
+		})
+		When("we use an unsigned tx that is not an ethereum msg", func() {
+			It("errors", func() {
 				sdkTx.On("GetMsgs").Return([]sdk.Msg{nil}).Once()
-				Expect(mempool.Insert(ctx, sdkTx)).ToNot(HaveOccurred())
+				Expect(mempool.Insert(ctx, sdkTx)).To(HaveOccurred())
 			})
 		})
 	})
 
+	When("we use the cosmos lane", func() {
+		var (
+			sender = secp256k1.GenPrivKey().PubKey()
+			lowTx  = newCosmosTx(sender, 0)
+			highTx = newCosmosTx(secp256k1.GenPrivKey().PubKey(), 0)
+		)
+
+		BeforeEach(func() {
+			Expect(mempool.Insert(ctx.WithPriority(1), lowTx)).To(Succeed())
+			Expect(mempool.Insert(ctx.WithPriority(10), highTx)).To(Succeed())
+		})
+
+		It("selects cosmos txs in priority order", func() {
+			iter := mempool.Select(ctx, nil)
+			Expect(iter).ToNot(BeNil())
+			Expect(iter.Tx()).To(Equal(highTx))
+			iter = iter.Next()
+			Expect(iter).ToNot(BeNil())
+			Expect(iter.Tx()).To(Equal(lowTx))
+			Expect(iter.Next()).To(BeNil())
+		})
+
+		It("removes cosmos txs on inclusion", func() {
+			Expect(mempool.Remove(highTx)).To(Succeed())
+			iter := mempool.Select(ctx, nil)
+			Expect(iter.Tx()).To(Equal(lowTx))
+			Expect(iter.Next()).To(BeNil())
+			Expect(mempool.Remove(highTx)).To(MatchError(sdkmempool.ErrTxNotFound))
+		})
+
+		It("does not remove ethereum txs", func() {
+			sdkTx.On("GetMsgs").Return([]sdk.Msg{wet}).Once()
+			Expect(mempool.Remove(sdkTx)).To(Succeed())
+		})
+
+		It("counts txs across both lanes", func() {
+			txPool.On("Stats").Return(16, 12).Once()
+			Expect(mempool.CountTx()).To(Equal(30))
+		})
+	})
+
 	When("we call stats", func() {
 		It("returns", func() {
 			txPool.On("Stats").Return(16, 12).Once()
@@ -96,3 +149,28 @@ var _ = Describe("", func() {
 		})
 	})
 })
+
+// cosmosTx is a minimal signed cosmos transaction used for testing the cosmos lane.
+type cosmosTx struct {
+	pubKey cryptotypes.PubKey
+	nonce  uint64
+	msgs   []sdk.Msg
+}
+
+func newCosmosTx(pubKey cryptotypes.PubKey, nonce uint64) *cosmosTx {
+	return &cosmosTx{pubKey: pubKey, nonce: nonce, msgs: []sdk.Msg{&banktypes.MsgSend{}}}
+}
+
+func (tx *cosmosTx) GetMsgs() []sdk.Msg { return tx.msgs }
+
+func (tx *cosmosTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+
+func (tx *cosmosTx) GetSigners() ([][]byte, error) { return [][]byte{tx.pubKey.Address()}, nil }
+
+func (tx *cosmosTx) GetPubKeys() ([]cryptotypes.PubKey, error) {
+	return []cryptotypes.PubKey{tx.pubKey}, nil
+}
+
+func (tx *cosmosTx) GetSignaturesV2() ([]signing.SignatureV2, error) {
+	return []signing.SignatureV2{{PubKey: tx.pubKey, Sequence: tx.nonce}}, nil
+}
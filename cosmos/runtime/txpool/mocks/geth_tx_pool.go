@@ -78,6 +78,54 @@ func (_c *GethTxPool_Add_Call) RunAndReturn(run func([]*types.Transaction, bool,
 	return _c
 }
 
+// Get provides a mock function with given fields: hash
+func (_m *GethTxPool) Get(hash common.Hash) *types.Transaction {
+	ret := _m.Called(hash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *types.Transaction
+	if rf, ok := ret.Get(0).(func(common.Hash) *types.Transaction); ok {
+		r0 = rf(hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Transaction)
+		}
+	}
+
+	return r0
+}
+
+// GethTxPool_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type GethTxPool_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - hash common.Hash
+func (_e *GethTxPool_Expecter) Get(hash interface{}) *GethTxPool_Get_Call {
+	return &GethTxPool_Get_Call{Call: _e.mock.On("Get", hash)}
+}
+
+func (_c *GethTxPool_Get_Call) Run(run func(hash common.Hash)) *GethTxPool_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(common.Hash))
+	})
+	return _c
+}
+
+func (_c *GethTxPool_Get_Call) Return(_a0 *types.Transaction) *GethTxPool_Get_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GethTxPool_Get_Call) RunAndReturn(run func(common.Hash) *types.Transaction) *GethTxPool_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Has provides a mock function with given fields: hash
 func (_m *GethTxPool) Has(hash common.Hash) bool {
 	ret := _m.Called(hash)
@@ -3,10 +3,14 @@
 package mocks
 
 import (
+	common "github.com/ethereum/go-ethereum/common"
 	core "github.com/ethereum/go-ethereum/core"
+
 	event "github.com/ethereum/go-ethereum/event"
 
 	mock "github.com/stretchr/testify/mock"
+
+	types "github.com/ethereum/go-ethereum/core/types"
 )
 
 // TxSubProvider is an autogenerated mock type for the TxSubProvider type
@@ -22,6 +26,100 @@ func (_m *TxSubProvider) EXPECT() *TxSubProvider_Expecter {
 	return &TxSubProvider_Expecter{mock: &_m.Mock}
 }
 
+// Get provides a mock function with given fields: hash
+func (_m *TxSubProvider) Get(hash common.Hash) *types.Transaction {
+	ret := _m.Called(hash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *types.Transaction
+	if rf, ok := ret.Get(0).(func(common.Hash) *types.Transaction); ok {
+		r0 = rf(hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Transaction)
+		}
+	}
+
+	return r0
+}
+
+// TxSubProvider_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type TxSubProvider_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - hash common.Hash
+func (_e *TxSubProvider_Expecter) Get(hash interface{}) *TxSubProvider_Get_Call {
+	return &TxSubProvider_Get_Call{Call: _e.mock.On("Get", hash)}
+}
+
+func (_c *TxSubProvider_Get_Call) Run(run func(hash common.Hash)) *TxSubProvider_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(common.Hash))
+	})
+	return _c
+}
+
+func (_c *TxSubProvider_Get_Call) Return(_a0 *types.Transaction) *TxSubProvider_Get_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TxSubProvider_Get_Call) RunAndReturn(run func(common.Hash) *types.Transaction) *TxSubProvider_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Has provides a mock function with given fields: hash
+func (_m *TxSubProvider) Has(hash common.Hash) bool {
+	ret := _m.Called(hash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Has")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(common.Hash) bool); ok {
+		r0 = rf(hash)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// TxSubProvider_Has_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Has'
+type TxSubProvider_Has_Call struct {
+	*mock.Call
+}
+
+// Has is a helper method to define mock.On call
+//   - hash common.Hash
+func (_e *TxSubProvider_Expecter) Has(hash interface{}) *TxSubProvider_Has_Call {
+	return &TxSubProvider_Has_Call{Call: _e.mock.On("Has", hash)}
+}
+
+func (_c *TxSubProvider_Has_Call) Run(run func(hash common.Hash)) *TxSubProvider_Has_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(common.Hash))
+	})
+	return _c
+}
+
+func (_c *TxSubProvider_Has_Call) Return(_a0 bool) *TxSubProvider_Has_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TxSubProvider_Has_Call) RunAndReturn(run func(common.Hash) bool) *TxSubProvider_Has_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SubscribeTransactions provides a mock function with given fields: ch, reorgs
 func (_m *TxSubProvider) SubscribeTransactions(ch chan<- core.NewTxsEvent, reorgs bool) event.Subscription {
 	ret := _m.Called(ch, reorgs)
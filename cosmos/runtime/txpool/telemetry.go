@@ -23,4 +23,7 @@ package txpool
 const (
 	MetricKeyMempoolFull      = "polaris_cometbft_mempool_full"
 	MetricKeyBroadcastFailure = "polaris_cometbft_broadcast_failure"
+	MetricKeyRetryQueued      = "polaris_cometbft_broadcast_retry_queued"
+	MetricKeyRetryDropped     = "polaris_cometbft_broadcast_retry_dropped"
+	MetricKeyRetryJournalSize = "polaris_cometbft_broadcast_retry_journal_size"
 )
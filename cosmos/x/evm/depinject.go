@@ -32,6 +32,8 @@ import (
 
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 //nolint:gochecknoinits // GRRRR fix later.
@@ -66,23 +68,33 @@ type DepInjectOutput struct {
 }
 
 // ProvideModule is a function that provides the module to the application.
-func ProvideModule(in DepInjectInput) DepInjectOutput {
+func ProvideModule(in DepInjectInput) (DepInjectOutput, error) {
 	// Default to empty precompile injector if not provided.
 	if in.CustomPrecompiles == nil {
 		in.CustomPrecompiles = func() *ethprecompile.Injector { return &ethprecompile.Injector{} }
 	}
 
-	k := keeper.NewKeeper(
+	// Default to the governance module as the authority if not provided.
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	if in.Config.Authority != "" {
+		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
+	}
+
+	k, err := keeper.NewKeeper(
 		in.AccountKeeper,
 		in.Key,
 		in.CustomPrecompiles,
 		in.QueryContextFn,
 		in.PolarisCfg(),
+		authority.String(),
 	)
+	if err != nil {
+		return DepInjectOutput{}, err
+	}
 	m := NewAppModule(k, in.AccountKeeper)
 
 	return DepInjectOutput{
 		Keeper: k,
 		Module: m,
-	}
+	}, nil
 }
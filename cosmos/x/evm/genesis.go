@@ -22,7 +22,9 @@ package evm
 
 import (
 	"encoding/json"
+	"fmt"
 
+	"github.com/berachain/polaris/cosmos/x/evm/types"
 	"github.com/berachain/polaris/eth/core"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -30,13 +32,22 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/ethereum/go-ethereum/common"
 )
 
+// genesisExtension holds the module params and the references to the chunks of the genesis
+// alloc in the evm genesis, which extend the Ethereum genesis as additional top-level fields.
+type genesisExtension struct {
+	Params      *types.Params             `json:"params,omitempty"`
+	AllocChunks []types.GenesisAllocChunk `json:"alloc_chunks,omitempty"`
+}
+
 // DefaultGenesis returns default genesis state as raw bytes for the evm
 // module.
 func (AppModuleBasic) DefaultGenesis(_ codec.JSONCodec) json.RawMessage {
-	ethGen := core.DefaultGenesis
-	rawGenesis, err := ethGen.MarshalJSON()
+	rawGenesis, err := marshalGenesis(core.DefaultGenesis, types.DefaultParams(), nil)
 	if err != nil {
 		panic(err)
 	}
@@ -48,14 +59,67 @@ func (AppModuleBasic) ValidateGenesis(
 	_ codec.JSONCodec,
 	_ client.TxEncodingConfig,
 	bz json.RawMessage,
+) error {
+	return validateGenesis(bz)
+}
+
+// ValidateGenesis performs genesis state validation for the evm module, which also checks that
+// the alloc does not collide with the precompiles injected into the chain.
+func (am AppModule) ValidateGenesis(
+	_ codec.JSONCodec,
+	_ client.TxEncodingConfig,
+	bz json.RawMessage,
+) error {
+	return validateGenesis(bz, am.keeper.GetPrecompileAddresses()...)
+}
+
+// ValidateGenesisAccounts checks that the nonces of the evm genesis accounts match the sequences
+// of the auth genesis accounts, as required by InitGenesis. The chunks of the genesis alloc, if
+// any, are read from the given genesis alloc directory one account at a time. Modules only
+// validate their own genesis, so it is run by the genesis validation of the app rather than by
+// ValidateGenesis.
+func ValidateGenesisAccounts(
+	cdc codec.Codec, appState map[string]json.RawMessage, allocDir string,
 ) error {
 	ethGen := new(core.Genesis)
-	if err := ethGen.UnmarshalJSON(bz); err != nil {
+	if err := ethGen.UnmarshalJSON(appState[types.ModuleName]); err != nil {
+		return err
+	}
+	_, chunks, err := unmarshalGenesisExtension(appState[types.ModuleName])
+	if err != nil {
+		return err
+	}
+	accounts, err := authtypes.UnpackAccounts(
+		authtypes.GetGenesisStateFromAppState(cdc, appState).Accounts,
+	)
+	if err != nil {
 		return err
 	}
 
-	// TODO: figure out what in geth we need to call in order to validate the genesis.
+	sequences := make(map[common.Address]uint64, len(accounts))
+	for _, acc := range accounts {
+		sequences[common.BytesToAddress(acc.GetAddress())] = acc.GetSequence()
+	}
+	validateNonce := func(addr common.Address, account core.GenesisAccount) error {
+		if sequence, ok := sequences[addr]; ok && account.Nonce != sequence {
+			return fmt.Errorf(
+				"%w: account nonce mismatch for (%s) between auth (%d) and evm (%d) genesis state",
+				types.ErrInvalidGenesis, addr.Hex(), sequence, account.Nonce,
+			)
+		}
+		return nil
+	}
 
+	for addr, account := range ethGen.Alloc {
+		if err = validateNonce(addr, account); err != nil {
+			return err
+		}
+	}
+	for _, chunk := range chunks {
+		if err = types.ReadGenesisAllocChunk(allocDir, chunk, validateNonce); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -71,19 +135,94 @@ func (am AppModule) InitGenesis(
 		panic(err)
 	}
 
-	if err := am.keeper.InitGenesis(ctx, &ethGen); err != nil {
+	params, chunks, err := unmarshalGenesisExtension(data)
+	if err != nil {
+		panic(err)
+	}
+	if err = am.keeper.SetParams(ctx, params); err != nil {
+		panic(err)
+	}
+
+	if err = am.keeper.InitGenesis(ctx, &ethGen, chunks); err != nil {
 		panic(err)
 	}
 	return []abci.ValidatorUpdate{}
 }
 
 // ExportGenesis returns the exported genesis state as raw bytes for the evm
-// module.
+// module. The genesis alloc is exported in chunks if a chunk size is configured.
 func (am AppModule) ExportGenesis(ctx sdk.Context, _ codec.JSONCodec) json.RawMessage {
 	ethGen := am.keeper.ExportGenesis(ctx)
-	ethGenBz, err := ethGen.MarshalJSON()
+	chunks, err := am.keeper.ExportGenesisAlloc(ctx)
+	if err != nil {
+		panic(err)
+	}
+	ethGenBz, err := marshalGenesis(ethGen, am.keeper.GetParams(ctx), chunks)
 	if err != nil {
 		panic(err)
 	}
 	return ethGenBz
 }
+
+// validateGenesis validates the Ethereum genesis and the params of the evm genesis.
+func validateGenesis(bz json.RawMessage, precompiles ...common.Address) error {
+	ethGen := new(core.Genesis)
+	if err := ethGen.UnmarshalJSON(bz); err != nil {
+		return err
+	}
+	if err := types.ValidateGenesis(ethGen, precompiles...); err != nil {
+		return err
+	}
+
+	params, chunks, err := unmarshalGenesisExtension(bz)
+	if err != nil {
+		return err
+	}
+	if len(chunks) > 0 && len(ethGen.Alloc) > 0 {
+		return fmt.Errorf("%w: alloc must be empty when it is in chunks", types.ErrInvalidGenesis)
+	}
+	if err = types.ValidateGenesisAllocChunks(chunks); err != nil {
+		return err
+	}
+	return params.Validate()
+}
+
+// marshalGenesis encodes the Ethereum genesis with the module params and the references to the
+// chunks of the genesis alloc, if any, added to it.
+func marshalGenesis(
+	ethGen *core.Genesis, params types.Params, chunks []types.GenesisAllocChunk,
+) (json.RawMessage, error) {
+	ethGenBz, err := ethGen.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err = json.Unmarshal(ethGenBz, &fields); err != nil {
+		return nil, err
+	}
+	if fields["params"], err = json.Marshal(&params); err != nil {
+		return nil, err
+	}
+	if len(chunks) > 0 {
+		if fields["alloc_chunks"], err = json.Marshal(chunks); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(fields)
+}
+
+// unmarshalGenesisExtension decodes the module params of the evm genesis, which default to
+// types.DefaultParams if they are absent, and the references to the chunks of the genesis alloc.
+func unmarshalGenesisExtension(
+	bz json.RawMessage,
+) (types.Params, []types.GenesisAllocChunk, error) {
+	var gen genesisExtension
+	if err := json.Unmarshal(bz, &gen); err != nil {
+		return types.Params{}, nil, err
+	}
+	if gen.Params == nil {
+		return types.DefaultParams(), gen.AllocChunks, nil
+	}
+	return *gen.Params, gen.AllocChunks, nil
+}
@@ -23,6 +23,8 @@ package evm_test
 import (
 	"encoding/json"
 	"math/big"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"cosmossdk.io/log"
@@ -33,13 +35,17 @@ import (
 	"github.com/berachain/polaris/cosmos/x/evm"
 	"github.com/berachain/polaris/cosmos/x/evm/keeper"
 	"github.com/berachain/polaris/cosmos/x/evm/plugins/state"
+	evmtypes "github.com/berachain/polaris/cosmos/x/evm/types"
 	"github.com/berachain/polaris/eth/core"
 	ethprecompile "github.com/berachain/polaris/eth/core/precompile"
 	"github.com/berachain/polaris/eth/params"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/ethereum/go-ethereum/consensus/beacon"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	ethparams "github.com/ethereum/go-ethereum/params"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -62,16 +68,13 @@ var _ = Describe("Genesis", func() {
 		k   *keeper.Keeper
 		am  evm.AppModule
 		err error
+
+		cfg      *config.Config
+		chainCfg ethparams.ChainConfig
 	)
 
-	BeforeEach(func() {
-		ctx, ak, _, _ = testutil.SetupMinimalKeepers(log.NewTestLogger(GinkgoT()))
-		ctx = ctx.WithBlockHeight(0)
-		cfg := config.DefaultConfig()
-		ethGen.Config = params.DefaultChainConfig
-		cfg.Node.DataDir = GinkgoT().TempDir()
-		cfg.Node.KeyStoreDir = GinkgoT().TempDir()
-		k = keeper.NewKeeper(
+	setupKeeper := func() {
+		k, err = keeper.NewKeeper(
 			ak,
 			testutil.EvmKey,
 			func() *ethprecompile.Injector {
@@ -83,15 +86,28 @@ var _ = Describe("Genesis", func() {
 				}
 			},
 			cfg,
+			authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		)
+		Expect(err).ToNot(HaveOccurred())
+		chainCfg = *params.DefaultChainConfig
 		err = k.Setup(
-			chain.New(core.NewChain(k.Host, params.DefaultChainConfig, beacon.NewFaker()), nil),
+			chain.New(core.NewChain(k.Host, &chainCfg, beacon.NewFaker()), nil, nil),
 		)
 		Expect(err).ToNot(HaveOccurred())
 
 		err = k.SetupPrecompiles()
 		Expect(err).ToNot(HaveOccurred())
 		am = evm.NewAppModule(k, ak)
+	}
+
+	BeforeEach(func() {
+		ctx, ak, _, _ = testutil.SetupMinimalKeepers(log.NewTestLogger(GinkgoT()))
+		ctx = ctx.WithBlockHeight(0)
+		cfg = config.DefaultConfig()
+		ethGen.Config = params.DefaultChainConfig
+		cfg.Node.DataDir = GinkgoT().TempDir()
+		cfg.Node.KeyStoreDir = GinkgoT().TempDir()
+		setupKeeper()
 	})
 
 	Describe("On InitGenesis", func() {
@@ -161,10 +177,144 @@ var _ = Describe("Genesis", func() {
 
 		Context("when the genesis is valid", func() {
 			It("should export without fail", func() {
-				ethGen.Config = nil
 				ethGen.BaseFee = big.NewInt(int64(ethparams.InitialBaseFee))
 				Expect(actualGenesis).To(Equal(*ethGen))
 			})
 		})
 	})
+
+	Describe("Params", func() {
+		var (
+			params = evmtypes.Params{
+				AllowedValidatorMsgs: []string{"cosmos.gov.v1.MsgVote"},
+				EvmGasRatioBps:       evmtypes.BasisPoints,
+			}
+			bz []byte
+		)
+		BeforeEach(func() {
+			var fields map[string]json.RawMessage
+			bz, err = json.Marshal(ethGen)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(json.Unmarshal(bz, &fields)).To(Succeed())
+			fields["params"], err = json.Marshal(params)
+			Expect(err).ToNot(HaveOccurred())
+			bz, err = json.Marshal(fields)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should default the params if they are not in the genesis", func() {
+			bz, err = json.Marshal(ethGen)
+			Expect(err).ToNot(HaveOccurred())
+			am.InitGenesis(ctx, nil, bz)
+			Expect(k.GetParams(ctx)).To(Equal(evmtypes.DefaultParams()))
+		})
+
+		It("should import and export the params", func() {
+			Expect(am.ValidateGenesis(nil, nil, bz)).To(Succeed())
+			am.InitGenesis(ctx, nil, bz)
+			Expect(k.GetParams(ctx)).To(Equal(params))
+
+			var exported struct {
+				Params evmtypes.Params `json:"params"`
+			}
+			Expect(json.Unmarshal(am.ExportGenesis(ctx, nil), &exported)).To(Succeed())
+			Expect(exported.Params).To(Equal(params))
+		})
+	})
+
+	Describe("Genesis alloc chunks", func() {
+		var (
+			exported json.RawMessage
+			header   *ethtypes.Header
+		)
+
+		BeforeEach(func() {
+			bz, err := json.Marshal(ethGen)
+			Expect(err).ToNot(HaveOccurred())
+			am.InitGenesis(ctx, nil, bz)
+			header, err = k.Host.GetBlockPlugin().GetHeaderByNumber(0)
+			Expect(err).ToNot(HaveOccurred())
+
+			// Export the alloc with one account per chunk, then import it into a new chain.
+			cfg.Polar.Genesis.AllocDir = GinkgoT().TempDir()
+			cfg.Polar.Genesis.AllocChunkSize = 1
+			setupKeeper()
+			exported = am.ExportGenesis(ctx, nil)
+
+			ctx, ak, _, _ = testutil.SetupMinimalKeepers(log.NewTestLogger(GinkgoT()))
+			ctx = ctx.WithBlockHeight(0)
+			setupKeeper()
+		})
+
+		It("should export and import the alloc in chunks", func() {
+			var gen struct {
+				Alloc       map[string]any               `json:"alloc"`
+				AllocChunks []evmtypes.GenesisAllocChunk `json:"alloc_chunks"`
+			}
+			Expect(json.Unmarshal(exported, &gen)).To(Succeed())
+			Expect(gen.Alloc).To(BeEmpty())
+			Expect(gen.AllocChunks).To(HaveLen(len(ethGen.Alloc)))
+			Expect(am.ValidateGenesis(nil, nil, exported)).To(Succeed())
+
+			am.InitGenesis(ctx, nil, exported)
+			sp := k.Host.GetStatePluginFactory().NewPluginFromContext(ctx)
+			for addr, acc := range ethGen.Alloc {
+				Expect(sp.GetBalance(addr)).To(Equal(acc.Balance))
+				Expect(sp.GetCode(addr)).To(Equal(acc.Code))
+				Expect(sp.GetNonce(addr)).To(Equal(acc.Nonce))
+			}
+		})
+
+		It("should have the same genesis block as without chunks", func() {
+			am.InitGenesis(ctx, nil, exported)
+			imported, err := k.Host.GetBlockPlugin().GetHeaderByNumber(0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(imported.Root).To(Equal(header.Root))
+			Expect(imported.Hash()).To(Equal(header.Hash()))
+
+			// The temporary database of the genesis alloc trie is removed after the import.
+			tries, err := filepath.Glob(filepath.Join(cfg.Polar.Genesis.AllocDir, "trie-*"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tries).To(BeEmpty())
+		})
+
+		It("should validate the nonces of the accounts in chunks against the auth genesis", func() {
+			cdc := testutil.GetEncodingConfig().Codec
+			// appState returns an app state whose auth genesis has an account for each account
+			// of the alloc, with a sequence that is off from its nonce by the given offset.
+			appState := func(offset uint64) map[string]json.RawMessage {
+				var accounts []authtypes.GenesisAccount
+				for addr, account := range ethGen.Alloc {
+					accounts = append(accounts,
+						authtypes.NewBaseAccount(addr.Bytes(), nil, 0, account.Nonce+offset))
+				}
+				auth, err := cdc.MarshalJSON(
+					authtypes.NewGenesisState(authtypes.DefaultParams(), accounts),
+				)
+				Expect(err).ToNot(HaveOccurred())
+				return map[string]json.RawMessage{
+					authtypes.ModuleName: auth, evmtypes.ModuleName: exported,
+				}
+			}
+
+			Expect(evm.ValidateGenesisAccounts(cdc, appState(0), cfg.Polar.Genesis.AllocDir)).
+				To(Succeed())
+			Expect(evm.ValidateGenesisAccounts(cdc, appState(1), cfg.Polar.Genesis.AllocDir)).
+				To(MatchError(evmtypes.ErrInvalidGenesis))
+		})
+
+		It("should refuse chunks that do not match their checksum", func() {
+			var gen struct {
+				AllocChunks []evmtypes.GenesisAllocChunk `json:"alloc_chunks"`
+			}
+			Expect(json.Unmarshal(exported, &gen)).To(Succeed())
+			path := filepath.Join(cfg.Polar.Genesis.AllocDir, gen.AllocChunks[0].File)
+			bz, err := os.ReadFile(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(os.WriteFile(path, append(bz, '\n'), 0o600)).To(Succeed())
+
+			Expect(func() { am.InitGenesis(ctx, nil, exported) }).
+				To(PanicWith(MatchError(evmtypes.ErrInvalidGenesisAllocChunk)))
+		})
+	})
 })
@@ -45,8 +45,25 @@ func (k *Keeper) EndBlock(ctx context.Context) error {
 	return nil
 }
 
-// SetLatestQueryContext runs on the Cosmos-SDK lifecycle PrepareCheckState() during ABCI Commit.
+// PrepareCheckState runs on the Cosmos-SDK lifecycle PrepareCheckState() during ABCI Commit,
+// after the block is committed. It sets the latest query context and runs the commit hook.
+func (k *Keeper) PrepareCheckState(ctx context.Context) error {
+	if err := k.SetLatestQueryContext(ctx); err != nil {
+		return err
+	}
+	if k.commitHook != nil {
+		k.commitHook(ctx)
+	}
+	return nil
+}
+
+// SetLatestQueryContext sets the context that the latest state is queried at.
 func (k *Keeper) SetLatestQueryContext(ctx context.Context) error {
 	k.spf.SetLatestQueryContext(ctx)
 	return nil
 }
+
+// SetCommitHook sets a function that runs in PrepareCheckState after every committed block.
+func (k *Keeper) SetCommitHook(hook func(context.Context)) {
+	k.commitHook = hook
+}
@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	"context"
+
+	"github.com/berachain/polaris/cosmos/x/evm/keeper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PrepareCheckState", func() {
+	var (
+		ctx sdk.Context
+		k   *keeper.Keeper
+	)
+
+	BeforeEach(func() {
+		ctx, k, _ = setupKeeper()
+	})
+
+	It("should run the commit hook after every committed block", func() {
+		var committed []int64
+		k.SetCommitHook(func(ctx context.Context) {
+			committed = append(committed, sdk.UnwrapSDKContext(ctx).BlockHeight())
+		})
+
+		Expect(k.PrepareCheckState(ctx.WithBlockHeight(1))).To(Succeed())
+		Expect(k.PrepareCheckState(ctx.WithBlockHeight(2))).To(Succeed())
+		Expect(committed).To(Equal([]int64{1, 2}))
+	})
+})
@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/berachain/polaris/cosmos/x/evm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// GetChainConfig returns the chain config in the x/evm state, which is nil until it is seeded
+// at genesis.
+func (k *Keeper) GetChainConfig(ctx context.Context) *params.ChainConfig {
+	bz := sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey).Get([]byte{types.ChainConfigPrefix})
+	if bz == nil {
+		return nil
+	}
+
+	cfg, err := types.UnmarshalChainConfig(bz)
+	if err != nil {
+		panic(err)
+	}
+	return cfg
+}
+
+// SetChainConfig validates and stores the chain config in the x/evm state. The chain config is
+// adopted by the node at the start of the next block.
+func (k *Keeper) SetChainConfig(ctx context.Context, cfg *params.ChainConfig) error {
+	bz, err := types.MarshalChainConfig(cfg)
+	if err != nil {
+		return err
+	}
+	sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey).Set([]byte{types.ChainConfigPrefix}, bz)
+	return nil
+}
+
+// ScheduleChainConfig stores the chain config in the x/evm state if it only changes the forks
+// that are not active at the next block, so that forks are scheduled at least two blocks ahead.
+// It is used by governance and may be called by upgrade handlers to schedule forks at an
+// upgrade height.
+func (k *Keeper) ScheduleChainConfig(ctx context.Context, cfg *params.ChainConfig) error {
+	current := k.GetChainConfig(ctx)
+	if current == nil {
+		current = k.chain.Config()
+	}
+
+	sCtx := sdk.UnwrapSDKContext(ctx)
+	if err := types.VerifyChainConfigUpdate(
+		current, cfg, uint64(sCtx.BlockHeight())+1, uint64(sCtx.BlockTime().Unix()),
+	); err != nil {
+		return err
+	}
+	return k.SetChainConfig(ctx, cfg)
+}
+
+// VerifyChainConfig adopts the chain config in the x/evm state as the chain config of the node.
+// It errors if a value that is set in the local config conflicts with the on-chain chain config,
+// so that a node refuses to start instead of diverging from the other validators.
+func (k *Keeper) VerifyChainConfig(ctx context.Context) error {
+	cfg := k.GetChainConfig(ctx)
+	if cfg == nil {
+		// The chain predates the on-chain chain config, so the local chain config is used.
+		return nil
+	}
+	if err := types.VerifyLocalChainConfig(&k.localChainConfig, cfg); err != nil {
+		return err
+	}
+	return k.SyncChainConfig(ctx)
+}
+
+// SyncChainConfig adopts the chain config in the x/evm state as the chain config of the node if
+// it changed since it was last adopted. Since forks are only scheduled for future blocks, it
+// runs in PreBlock, which proposals also run before they are prepared or processed, so that all
+// nodes activate them at the same block.
+func (k *Keeper) SyncChainConfig(ctx context.Context) error {
+	bz := sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey).Get([]byte{types.ChainConfigPrefix})
+	if bz == nil || bytes.Equal(bz, k.chainConfigBz) {
+		return nil
+	}
+
+	cfg, err := types.UnmarshalChainConfig(bz)
+	if err != nil {
+		return err
+	}
+	// The chain config is read concurrently by the execution layer, so it is swapped rather
+	// than modified in place.
+	k.chain.SetConfig(cfg)
+	k.Host.SetChainConfig(cfg)
+	k.chainConfigBz = bz
+	return nil
+}
+
+// UpdateChainConfig implements the MsgServer interface. It schedules forks in the chain config
+// if the message is signed by the module authority.
+func (k *Keeper) UpdateChainConfig(
+	ctx context.Context, msg *types.MsgUpdateChainConfig,
+) (*types.MsgUpdateChainConfigResponse, error) {
+	if msg.Authority != k.authority {
+		return nil, fmt.Errorf(
+			"%w: expected %s, got %s", govtypes.ErrInvalidSigner, k.authority, msg.Authority,
+		)
+	}
+
+	cfg, err := types.UnmarshalChainConfig([]byte(msg.ChainConfig))
+	if err != nil {
+		return nil, err
+	}
+	if err = k.ScheduleChainConfig(ctx, cfg); err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateChainConfigResponse{}, nil
+}
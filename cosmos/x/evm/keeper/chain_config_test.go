@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"time"
+
+	"github.com/berachain/polaris/cosmos/runtime/chain"
+	"github.com/berachain/polaris/cosmos/x/evm/keeper"
+	evmtypes "github.com/berachain/polaris/cosmos/x/evm/types"
+	"github.com/berachain/polaris/eth/core"
+	"github.com/berachain/polaris/eth/params"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/ethereum/go-ethereum/consensus/beacon"
+	ethparams "github.com/ethereum/go-ethereum/params"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Chain config", func() {
+	var (
+		ctx    sdk.Context
+		k      *keeper.Keeper
+		bc     core.Blockchain
+		genCfg ethparams.ChainConfig
+	)
+
+	BeforeEach(func() {
+		ctx, k, bc = setupKeeper()
+		genCfg = *params.DefaultChainConfig
+	})
+
+	It("should seed the chain config from the genesis", func() {
+		genCfg.PragueTime = new(uint64)
+		Expect(initGenesis(ctx, k, &genCfg)).To(Succeed())
+		Expect(k.GetChainConfig(ctx)).To(Equal(&genCfg))
+		Expect(bc.Config().PragueTime).To(Equal(genCfg.PragueTime))
+	})
+
+	It("should refuse a local chain config that conflicts with the genesis", func() {
+		genCfg.ChainID = big.NewInt(1)
+		Expect(initGenesis(ctx, k, &genCfg)).To(MatchError(evmtypes.ErrChainConfigConflict))
+	})
+
+	It("should only schedule forks that are not active", func() {
+		Expect(initGenesis(ctx, k, &genCfg)).To(Succeed())
+		ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(100, 0))
+
+		scheduled := genCfg
+		scheduled.PragueTime = new(uint64)
+		Expect(k.ScheduleChainConfig(ctx, &scheduled)).
+			To(MatchError(evmtypes.ErrInvalidChainConfig))
+
+		*scheduled.PragueTime = 200
+		Expect(k.ScheduleChainConfig(ctx, &scheduled)).To(Succeed())
+		local := bc.Config()
+		Expect(local.PragueTime).To(BeNil())
+		Expect(k.SyncChainConfig(ctx)).To(Succeed())
+		Expect(bc.Config().PragueTime).To(Equal(scheduled.PragueTime))
+
+		// The chain config is swapped rather than modified, as it is read concurrently.
+		Expect(local.PragueTime).To(BeNil())
+
+		scheduled.ChainID = big.NewInt(1)
+		Expect(k.ScheduleChainConfig(ctx, &scheduled)).
+			To(MatchError(evmtypes.ErrInvalidChainConfig))
+	})
+
+	It("should only schedule forks at least two blocks ahead", func() {
+		genCfg.MergeNetsplitBlock = nil
+		local := genCfg
+		Expect(k.Setup(chain.New(core.NewChain(k.Host, &local, beacon.NewFaker()), nil, nil))).
+			To(Succeed())
+		Expect(initGenesis(ctx, k, &genCfg)).To(Succeed())
+		ctx = ctx.WithBlockHeight(10)
+
+		scheduled := genCfg
+		scheduled.MergeNetsplitBlock = big.NewInt(11)
+		Expect(k.ScheduleChainConfig(ctx, &scheduled)).
+			To(MatchError(evmtypes.ErrInvalidChainConfig))
+
+		scheduled.MergeNetsplitBlock = big.NewInt(12)
+		Expect(k.ScheduleChainConfig(ctx, &scheduled)).To(Succeed())
+	})
+
+	It("should only be updated by the authority", func() {
+		Expect(initGenesis(ctx, k, &genCfg)).To(Succeed())
+		ctx = ctx.WithBlockTime(time.Unix(100, 0))
+		scheduled := genCfg
+		scheduled.PragueTime = new(uint64)
+		*scheduled.PragueTime = 200
+		cfgBz, err := json.Marshal(&scheduled)
+		Expect(err).ToNot(HaveOccurred())
+
+		update := &evmtypes.MsgUpdateChainConfig{
+			Authority:   authtypes.NewModuleAddress("evm").String(),
+			ChainConfig: string(cfgBz),
+		}
+		_, err = k.UpdateChainConfig(ctx, update)
+		Expect(err).To(MatchError(govtypes.ErrInvalidSigner))
+
+		update.Authority = k.GetAuthority()
+		_, err = k.UpdateChainConfig(ctx, update)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(k.GetChainConfig(ctx)).To(Equal(&scheduled))
+	})
+
+	It("should verify the local chain config on startup", func() {
+		Expect(initGenesis(ctx, k, &genCfg)).To(Succeed())
+		Expect(k.VerifyChainConfig(ctx)).To(Succeed())
+
+		scheduled := genCfg
+		scheduled.PragueTime = new(uint64)
+		*scheduled.PragueTime = 200
+		Expect(k.ScheduleChainConfig(ctx.WithBlockTime(time.Unix(100, 0)), &scheduled)).
+			To(Succeed())
+		Expect(k.VerifyChainConfig(ctx)).To(Succeed())
+		Expect(bc.Config().PragueTime).To(Equal(scheduled.PragueTime))
+
+		// A restarted node that sets a conflicting fork locally refuses to start.
+		local := scheduled
+		local.PragueTime = new(uint64)
+		Expect(k.Setup(chain.New(core.NewChain(k.Host, &local, beacon.NewFaker()), nil, nil))).
+			To(Succeed())
+		Expect(k.VerifyChainConfig(ctx)).To(MatchError(evmtypes.ErrChainConfigConflict))
+	})
+})
@@ -21,16 +21,59 @@
 package keeper
 
 import (
+	"fmt"
+	"os"
+
 	"github.com/berachain/polaris/cosmos/x/evm/plugins"
+	"github.com/berachain/polaris/cosmos/x/evm/types"
 	"github.com/berachain/polaris/eth/core"
 	"github.com/berachain/polaris/lib/utils"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	ethstate "github.com/ethereum/go-ethereum/core/state"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// genesisTrieDirPattern is the pattern of the temporary directory in the genesis alloc
+	// directory that backs the trie of the genesis alloc while it is imported.
+	genesisTrieDirPattern = "trie-*"
+	// genesisTrieDBNamespace is the metrics namespace of the genesis alloc trie database.
+	genesisTrieDBNamespace = "polaris/db/genesistrie/"
+	// genesisTrieDBCache is the memory (in MB) allotted to the genesis alloc trie database cache.
+	genesisTrieDBCache = 16
+	// genesisTrieDBHandles is the number of file handles allotted to the genesis alloc trie
+	// database.
+	genesisTrieDBHandles = 16
 )
 
-// InitGenesis is called during the InitGenesis.
-func (k *Keeper) InitGenesis(ctx sdk.Context, genState *core.Genesis) error {
-	// TODO: Feels jank as fuck lol, but it works.
+// InitGenesis is called during the InitGenesis. The chunks of the genesis alloc, if any, are
+// imported from the genesis alloc directory, and their accounts make up the state root of the
+// genesis block.
+func (k *Keeper) InitGenesis(
+	ctx sdk.Context, genState *core.Genesis, chunks []types.GenesisAllocChunk,
+) error {
+	if err := types.ValidateGenesis(genState, k.GetPrecompileAddresses()...); err != nil {
+		return err
+	}
+
+	// Seed the on-chain chain config from the genesis, or from the local chain config if the
+	// genesis does not set one, and adopt it.
+	if genState.Config == nil {
+		genState.Config = k.chain.Config()
+	}
+	if err := types.VerifyLocalChainConfig(&k.localChainConfig, genState.Config); err != nil {
+		return err
+	}
+	if err := k.SetChainConfig(ctx, genState.Config); err != nil {
+		return err
+	}
+	if err := k.SyncChainConfig(ctx); err != nil {
+		return err
+	}
 	genState.Config = k.chain.Config()
 
 	// Initialize all the plugins.
@@ -44,17 +87,157 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, genState *core.Genesis) error {
 	}
 
 	// Insert to chain.
+	block := genState.ToBlock()
+	if len(chunks) > 0 {
+		root, err := k.importGenesisAlloc(ctx, chunks)
+		if err != nil {
+			return err
+		}
+		block = genState.ToBlockWithRoot(root)
+	}
 	k.spf.SetGenesisContext(ctx)
-	return k.chain.WriteGenesisBlockWithContext(ctx, genState.ToBlock())
+	return k.chain.WriteGenesisBlockWithContext(ctx, block)
 }
 
-// ExportGenesis returns the exported genesis state.
+// ExportGenesis returns the exported genesis state. If the genesis alloc is exported in chunks,
+// the returned genesis has no alloc.
 func (k *Keeper) ExportGenesis(ctx sdk.Context) *core.Genesis {
 	genesisState := new(core.Genesis)
+	genesisState.Config = k.GetChainConfig(ctx)
 	for _, plugin := range k.Host.GetAllPlugins() {
+		if k.genesisCfg.AllocChunkSize > 0 && plugin == k.Host.sp {
+			continue
+		}
 		if plugin, ok := utils.GetAs[plugins.HasGenesis](plugin); ok {
 			plugin.ExportGenesis(ctx, genesisState)
 		}
 	}
+	if genesisState.Alloc == nil {
+		genesisState.Alloc = make(core.GenesisAlloc)
+	}
 	return genesisState
 }
+
+// ExportGenesisAlloc exports the genesis alloc to chunk files in the genesis alloc directory, if
+// a chunk size is configured, and returns the references to the chunks. Only one chunk of the
+// alloc is held in memory at a time.
+func (k *Keeper) ExportGenesisAlloc(ctx sdk.Context) ([]types.GenesisAllocChunk, error) {
+	if k.genesisCfg.AllocChunkSize == 0 {
+		return nil, nil
+	}
+	w, err := types.NewGenesisAllocWriter(k.genesisCfg.AllocDir, k.genesisCfg.AllocChunkSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var accounts uint64
+	if err = k.Host.sp.ExportGenesisAlloc(
+		ctx, func(address common.Address, account core.GenesisAccount) error {
+			if err = w.Write(address, account); err != nil {
+				return err
+			}
+			if accounts++; accounts%k.genesisCfg.AllocChunkSize == 0 {
+				k.Logger(ctx).Info("exported genesis alloc chunk",
+					"chunks", len(w.Chunks()), "accounts", accounts)
+			}
+			return nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	chunks, err := w.Close()
+	if err != nil {
+		return nil, err
+	}
+	k.Logger(ctx).Info("exported genesis alloc",
+		"dir", k.genesisCfg.AllocDir, "chunks", len(chunks), "accounts", accounts)
+	return chunks, nil
+}
+
+// importGenesisAlloc imports the chunks of the genesis alloc from the genesis alloc directory,
+// one chunk at a time, and returns the state root of the alloc. The checksum of every chunk file
+// is verified before it is imported, and the accounts are validated as those of the genesis
+// alloc, and must be in ascending order of address across all chunks.
+func (k *Keeper) importGenesisAlloc(
+	ctx sdk.Context, chunks []types.GenesisAllocChunk,
+) (common.Hash, error) {
+	validateAccount := types.NewGenesisAccountValidator(k.GetPrecompileAddresses()...)
+
+	// The state root is computed as by the genesis specification on an ephemeral trie, which is
+	// backed by a temporary database in the genesis alloc directory and flushed to it after every
+	// chunk, so that at most the nodes of one chunk are held in memory.
+	dir, err := os.MkdirTemp(k.genesisCfg.AllocDir, genesisTrieDirPattern)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	defer os.RemoveAll(dir)
+	diskdb, err := rawdb.NewLevelDBDatabase(
+		dir, genesisTrieDBCache, genesisTrieDBHandles, genesisTrieDBNamespace, false,
+	)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	defer diskdb.Close()
+	db := ethstate.NewDatabase(diskdb)
+	root := ethtypes.EmptyRootHash
+
+	var (
+		last     *common.Address
+		accounts uint64
+	)
+	for i, chunk := range chunks {
+		alloc := make(core.GenesisAlloc, chunk.Accounts)
+		if err := types.ReadGenesisAllocChunk(
+			k.genesisCfg.AllocDir, chunk,
+			func(address common.Address, account core.GenesisAccount) error {
+				if last != nil && address.Cmp(*last) <= 0 {
+					return fmt.Errorf("%w: account %s is out of order in file %s",
+						types.ErrInvalidGenesisAllocChunk, address.Hex(), chunk.File)
+				}
+				last = &address
+				alloc[address] = account
+				return validateAccount(address, account)
+			},
+		); err != nil {
+			return common.Hash{}, err
+		}
+
+		if err = k.Host.sp.InitGenesisAlloc(ctx, alloc); err != nil {
+			return common.Hash{}, err
+		}
+		if root, err = hashGenesisAlloc(db, root, alloc); err != nil {
+			return common.Hash{}, err
+		}
+		accounts += chunk.Accounts
+		k.Logger(ctx).Info("imported genesis alloc chunk",
+			"file", chunk.File, "chunks", fmt.Sprintf("%d/%d", i+1, len(chunks)),
+			"accounts", accounts)
+	}
+	return root, nil
+}
+
+// hashGenesisAlloc adds the accounts of the genesis alloc to the state with the given root in
+// the given database, and returns the state root after they are committed and flushed to disk.
+func hashGenesisAlloc(
+	db ethstate.Database, root common.Hash, alloc core.GenesisAlloc,
+) (common.Hash, error) {
+	statedb, err := ethstate.New(root, db, nil)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	for address, account := range alloc {
+		if account.Balance != nil {
+			statedb.AddBalance(address, account.Balance)
+		}
+		statedb.SetCode(address, account.Code)
+		statedb.SetNonce(address, account.Nonce)
+		for key, value := range account.Storage {
+			statedb.SetState(address, key, value)
+		}
+	}
+	if root, err = statedb.Commit(0, false); err != nil {
+		return common.Hash{}, err
+	}
+	return root, db.TrieDB().Commit(root, false)
+}
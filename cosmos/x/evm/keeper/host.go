@@ -21,8 +21,12 @@
 package keeper
 
 import (
+	"sync/atomic"
+
 	storetypes "cosmossdk.io/store/types"
 
+	dbm "github.com/cosmos/cosmos-db"
+
 	"github.com/berachain/polaris/cosmos/config"
 	"github.com/berachain/polaris/cosmos/x/evm/plugins/block"
 	"github.com/berachain/polaris/cosmos/x/evm/plugins/historical"
@@ -34,6 +38,9 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
 )
 
 // Compile-time interface assertion.
@@ -47,6 +54,13 @@ type Host struct {
 	sp  state.Plugin
 	spf *state.SPFactory
 
+	// historicalDB is the node-local database of the historical plugin, if it has one.
+	historicalDB dbm.DB
+
+	// chainConfig is the chain config that the historical plugin derives receipts with. It is
+	// swapped rather than modified when the chain config changes, as it is read concurrently.
+	chainConfig atomic.Pointer[params.ChainConfig]
+
 	pcs func() *ethprecompile.Injector
 }
 
@@ -57,7 +71,7 @@ func NewHost(
 	ak state.AccountKeeper,
 	precompiles func() *ethprecompile.Injector,
 	qc func() func(height int64, prove bool) (sdk.Context, error),
-) *Host {
+) (*Host, error) {
 	// We setup the host with some Cosmos standard sauce.
 	h := &Host{
 		bp: block.NewPlugin(
@@ -67,11 +81,34 @@ func NewHost(
 		pp:  precompile.NewPlugin(),
 		sp:  state.NewPlugin(ak, storeKey, qc, nil),
 	}
-
-	// historical plugin requires block plugin.
-	h.hp = historical.NewPlugin(&cfg.Polar.Chain, h.bp, nil, storeKey)
+	h.chainConfig.Store(&cfg.Polar.Chain)
+
+	// historical plugin requires block plugin, unless it is backed by a node-local database.
+	if hcfg := cfg.Polar.Historical; hcfg.Backend != "" {
+		db, err := historical.OpenLocalDB(hcfg.Backend, hcfg.Datadir)
+		if err != nil {
+			return nil, err
+		}
+		h.historicalDB = db
+		h.hp = historical.NewLocalPlugin(h.chainConfig.Load, db)
+	} else {
+		h.hp = historical.NewPlugin(h.chainConfig.Load, h.bp, nil, storeKey)
+	}
 	h.spf = state.NewSPFactory(ak, storeKey, qc)
-	return h
+	return h, nil
+}
+
+// Close closes the node-local database of the historical plugin, if it has one.
+func (h *Host) Close() error {
+	if h.historicalDB == nil {
+		return nil
+	}
+	return h.historicalDB.Close()
+}
+
+// SetChainConfig sets the chain config that the historical plugin derives receipts with.
+func (h *Host) SetChainConfig(cfg *params.ChainConfig) {
+	h.chainConfig.Store(cfg)
 }
 
 // SetupPrecompiles intializes the precompile contracts.
@@ -88,6 +125,17 @@ func (h *Host) SetupPrecompiles() error {
 	return nil
 }
 
+// GetPrecompileAddresses returns the addresses of the precompile contracts injected into the
+// chain, which do not need to be registered yet.
+func (h *Host) GetPrecompileAddresses() []common.Address {
+	pcs := h.pcs().GetPrecompiles()
+	addrs := make([]common.Address, 0, len(pcs))
+	for _, pc := range pcs {
+		addrs = append(addrs, pc.RegistryKey())
+	}
+	return addrs
+}
+
 // GetBlockPlugin returns the header plugin.
 func (h *Host) GetBlockPlugin() core.BlockPlugin {
 	return h.bp
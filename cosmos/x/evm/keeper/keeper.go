@@ -21,6 +21,9 @@
 package keeper
 
 import (
+	"context"
+	"time"
+
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 
@@ -29,8 +32,11 @@ import (
 	"github.com/berachain/polaris/cosmos/x/evm/types"
 	"github.com/berachain/polaris/eth/core"
 	ethprecompile "github.com/berachain/polaris/eth/core/precompile"
+	"github.com/berachain/polaris/eth/polar"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/params"
 )
 
 type Keeper struct {
@@ -39,6 +45,26 @@ type Keeper struct {
 
 	// provider is the struct that houses the Polaris EVM.
 	chain core.Blockchain
+
+	// storeKey is the key of the evm store, which holds the module params.
+	storeKey storetypes.StoreKey
+	// authority is the address that is allowed to update the module params.
+	authority string
+
+	// rpcGasCap and rpcEVMTimeout bound the gas and time of calls served by the query service.
+	rpcGasCap     uint64
+	rpcEVMTimeout time.Duration
+
+	// genesisCfg configures the export and import of the genesis alloc in chunks.
+	genesisCfg polar.GenesisConfig
+
+	// localChainConfig is the chain config of the node before the on-chain chain config is
+	// adopted, and chainConfigBz is the encoding of the adopted on-chain chain config.
+	localChainConfig params.ChainConfig
+	chainConfigBz    []byte
+
+	// commitHook runs after every committed block.
+	commitHook func(context.Context)
 }
 
 // NewKeeper creates new instances of the polaris Keeper.
@@ -48,21 +74,31 @@ func NewKeeper(
 	pcs func() *ethprecompile.Injector,
 	qc func() func(height int64, prove bool) (sdk.Context, error),
 	polarisCfg *config.Config,
-) *Keeper {
-	host := NewHost(
+	authority string,
+) (*Keeper, error) {
+	host, err := NewHost(
 		*polarisCfg,
 		storeKey,
 		ak,
 		pcs,
 		qc,
 	)
-	return &Keeper{
-		Host: host,
+	if err != nil {
+		return nil, err
 	}
+	return &Keeper{
+		Host:          host,
+		storeKey:      storeKey,
+		authority:     authority,
+		rpcGasCap:     polarisCfg.Polar.RPCGasCap,
+		rpcEVMTimeout: polarisCfg.Polar.RPCEVMTimeout,
+		genesisCfg:    polarisCfg.Polar.Genesis,
+	}, nil
 }
 
 func (k *Keeper) Setup(chain core.Blockchain) error {
 	k.chain = chain
+	k.localChainConfig = *chain.Config()
 	return k.SetupPrecompiles()
 }
 
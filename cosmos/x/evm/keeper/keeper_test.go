@@ -23,6 +23,23 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/log"
+
+	"github.com/berachain/polaris/cosmos/config"
+	"github.com/berachain/polaris/cosmos/runtime/chain"
+	testutil "github.com/berachain/polaris/cosmos/testutil"
+	"github.com/berachain/polaris/cosmos/x/evm/keeper"
+	"github.com/berachain/polaris/eth/core"
+	ethprecompile "github.com/berachain/polaris/eth/core/precompile"
+	"github.com/berachain/polaris/eth/params"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/ethereum/go-ethereum/consensus/beacon"
+	ethparams "github.com/ethereum/go-ethereum/params"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -31,3 +48,42 @@ func TestKeeper(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "cosmos/x/evm/keeper")
 }
+
+// setupKeeper creates a keeper on the minimal keepers of a new context, and sets it up with a
+// new chain, whose local chain config is a copy of the default one. The chain is returned along
+// with the keeper.
+func setupKeeper() (sdk.Context, *keeper.Keeper, core.Blockchain) {
+	ctx, ak, _, _ := testutil.SetupMinimalKeepers(log.NewTestLogger(GinkgoT()))
+	ctx = ctx.WithBlockHeight(0)
+
+	cfg := config.DefaultConfig()
+	cfg.Node.DataDir = GinkgoT().TempDir()
+	cfg.Node.KeyStoreDir = GinkgoT().TempDir()
+	k, err := keeper.NewKeeper(
+		ak,
+		testutil.EvmKey,
+		func() *ethprecompile.Injector {
+			return ethprecompile.NewPrecompiles([]ethprecompile.Registrable{}...)
+		},
+		func() func(height int64, prove bool) (sdk.Context, error) {
+			return func(height int64, prove bool) (sdk.Context, error) {
+				return ctx, nil
+			}
+		},
+		cfg,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	Expect(err).ToNot(HaveOccurred())
+
+	chainCfg := *params.DefaultChainConfig
+	bc := core.NewChain(k.Host, &chainCfg, beacon.NewFaker())
+	Expect(k.Setup(chain.New(bc, nil, nil))).To(Succeed())
+	return ctx, k, bc
+}
+
+// initGenesis inits the keeper with the default evm genesis and the given chain config.
+func initGenesis(ctx sdk.Context, k *keeper.Keeper, chainCfg *ethparams.ChainConfig) error {
+	gen := *core.DefaultGenesis
+	gen.Config = chainCfg
+	return k.InitGenesis(ctx, &gen, nil)
+}
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"github.com/berachain/polaris/cosmos/x/evm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator migrates the x/evm state between consensus versions.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a Migrator for the given keeper.
+func NewMigrator(k *Keeper) Migrator {
+	return Migrator{keeper: k}
+}
+
+// Migrate1to2 stores the params of chains that predate them. Their proposers placed payload
+// envelopes in JSON, so the stored params keep the JSON envelope encoding, rather than the
+// default encoding of new chains, until it is changed by governance.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if ctx.KVStore(m.keeper.storeKey).Has([]byte{types.ParamsKey}) {
+		return nil
+	}
+
+	params := types.DefaultParams()
+	params.EnvelopeEncoding = types.EnvelopeEncoding_ENVELOPE_ENCODING_JSON
+	return m.keeper.SetParams(ctx, params)
+}
@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	"github.com/berachain/polaris/cosmos/x/evm/keeper"
+	evmtypes "github.com/berachain/polaris/cosmos/x/evm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Migrations", func() {
+	var (
+		ctx sdk.Context
+		k   *keeper.Keeper
+	)
+
+	BeforeEach(func() {
+		ctx, k, _ = setupKeeper()
+	})
+
+	It("should keep the JSON envelope encoding of chains without params", func() {
+		Expect(keeper.NewMigrator(k).Migrate1to2(ctx)).To(Succeed())
+
+		expected := evmtypes.DefaultParams()
+		expected.EnvelopeEncoding = evmtypes.EnvelopeEncoding_ENVELOPE_ENCODING_JSON
+		Expect(k.GetParams(ctx)).To(Equal(expected))
+	})
+
+	It("should leave stored params unchanged", func() {
+		params := evmtypes.DefaultParams()
+		params.EnvelopeEncoding = evmtypes.EnvelopeEncoding_ENVELOPE_ENCODING_RLP_ZSTD
+		Expect(k.SetParams(ctx, params)).To(Succeed())
+
+		Expect(keeper.NewMigrator(k).Migrate1to2(ctx)).To(Succeed())
+		Expect(k.GetParams(ctx)).To(Equal(params))
+	})
+})
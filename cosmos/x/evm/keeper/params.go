@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/berachain/polaris/cosmos/x/evm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// GetParams returns the parameters of the x/evm module, which are the defaults until they are
+// first set.
+func (k *Keeper) GetParams(ctx context.Context) types.Params {
+	bz := sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey).Get([]byte{types.ParamsKey})
+	if bz == nil {
+		return types.DefaultParams()
+	}
+
+	var params types.Params
+	if err := params.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return params
+}
+
+// SetParams validates and stores the parameters of the x/evm module.
+func (k *Keeper) SetParams(ctx context.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := params.Marshal()
+	if err != nil {
+		return err
+	}
+	sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey).Set([]byte{types.ParamsKey}, bz)
+	return nil
+}
+
+// GetAuthority returns the address that is allowed to update the module params.
+func (k *Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// UpdateParams implements the MsgServer interface. It updates the module params if the message
+// is signed by the module authority.
+func (k *Keeper) UpdateParams(
+	ctx context.Context, msg *types.MsgUpdateParams,
+) (*types.MsgUpdateParamsResponse, error) {
+	if msg.Authority != k.authority {
+		return nil, fmt.Errorf(
+			"%w: expected %s, got %s", govtypes.ErrInvalidSigner, k.authority, msg.Authority,
+		)
+	}
+
+	if err := k.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// Params implements the QueryServer interface. It returns the module params.
+func (k *Keeper) Params(
+	ctx context.Context, _ *types.QueryParamsRequest,
+) (*types.QueryParamsResponse, error) {
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	"github.com/berachain/polaris/cosmos/x/evm/keeper"
+	evmtypes "github.com/berachain/polaris/cosmos/x/evm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Params", func() {
+	var (
+		ctx sdk.Context
+		k   *keeper.Keeper
+	)
+
+	BeforeEach(func() {
+		ctx, k, _ = setupKeeper()
+	})
+
+	It("should default the params until they are set", func() {
+		Expect(k.GetParams(ctx)).To(Equal(evmtypes.DefaultParams()))
+	})
+
+	It("should only be updated by the authority", func() {
+		update := &evmtypes.MsgUpdateParams{
+			Authority: k.GetAuthority(),
+			Params: evmtypes.Params{
+				AllowedValidatorMsgs: []string{"cosmos.gov.v1.MsgVote"},
+				EvmGasRatioBps:       evmtypes.BasisPoints,
+			},
+		}
+		_, err := k.UpdateParams(ctx, update)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(k.GetParams(ctx)).To(Equal(update.Params))
+
+		update.Authority = authtypes.NewModuleAddress("evm").String()
+		_, err = k.UpdateParams(ctx, update)
+		Expect(err).To(MatchError(govtypes.ErrInvalidSigner))
+	})
+
+	It("should reject allowing evm msgs", func() {
+		update := &evmtypes.MsgUpdateParams{
+			Authority: k.GetAuthority(),
+			Params: evmtypes.Params{
+				AllowedValidatorMsgs: []string{"polaris.evm.v1alpha1.WrappedPayloadEnvelope"},
+				EvmGasRatioBps:       evmtypes.BasisPoints,
+			},
+		}
+		_, err := k.UpdateParams(ctx, update)
+		Expect(err).To(HaveOccurred())
+	})
+})
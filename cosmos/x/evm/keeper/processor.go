@@ -25,6 +25,8 @@ import (
 	"fmt"
 	"time"
 
+	storetypes "cosmossdk.io/store/types"
+
 	evmtypes "github.com/berachain/polaris/cosmos/x/evm/types"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -43,24 +45,17 @@ func (k *Keeper) ProcessPayloadEnvelope(
 	var (
 		err      error
 		block    *ethtypes.Block
-		envelope engine.ExecutionPayloadEnvelope
+		envelope *engine.ExecutionPayloadEnvelope
 	)
-	// TODO: maybe we just consume the block gas limit and call it a day?
 	sCtx := sdk.UnwrapSDKContext(ctx)
-	gasMeter := sCtx.GasMeter()
-	blockGasMeter := sCtx.BlockGasMeter()
-
-	// Reset GasMeter to 0.
-	//
-	// TODO: we need to remove this next re-genesis.
-	defer gasMeter.RefundGas(gasMeter.GasConsumed(), "reset after evm")
-	defer blockGasMeter.RefundGas(blockGasMeter.GasConsumed(), "reset after evm")
 
-	if err = envelope.UnmarshalJSON(msg.Data); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal payload envelope: %w", err)
+	if envelope, err = msg.UnwrapPayload(); err != nil {
+		return nil, fmt.Errorf("failed to unwrap payload envelope: %w", err)
 	}
 
-	if block, err = engine.ExecutableDataToBlock(*envelope.ExecutionPayload, nil, nil); err != nil {
+	if block, err = evmtypes.EnvelopeToBlock(
+		k.chain.Config(), envelope, evmtypes.ParentBeaconRoot(sCtx),
+	); err != nil {
 		k.Logger(sCtx).Error("failed to build evm block", "err", err)
 		return nil, err
 	}
@@ -69,13 +64,22 @@ func (k *Keeper) ProcessPayloadEnvelope(
 	defer telemetry.ModuleMeasureSince(evmtypes.ModuleName,
 		time.Now(), evmtypes.MetricKeyInsertBlockAndSetHead)
 
+	// The block is executed with its own gas meter, as only the gas used by the evm block,
+	// converted into Cosmos gas, is charged for it, which is then consumed from the block gas
+	// meter.
+	execCtx := sCtx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
 	// Set the finalize block context on the state plugin factory.
-	k.spf.SetFinalizeBlockContext(ctx)
+	k.spf.SetFinalizeBlockContext(execCtx)
 	// Insert the finalized block and set the chain head.
-	if err = k.chain.InsertBlockAndSetHeadWithContext(ctx, block); err != nil {
+	if err = k.chain.InsertBlockAndSetHeadWithContext(execCtx, block); err != nil {
 		return nil, err
 	}
 
+	sCtx.GasMeter().ConsumeGas(
+		k.GetParams(ctx).EVMGasToCosmos(block.GasUsed()), "evm block gas used",
+	)
+
 	return &evmtypes.WrappedPayloadEnvelopeResponse{}, nil
 }
 
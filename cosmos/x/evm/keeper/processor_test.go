@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	"math/big"
+
+	"github.com/berachain/polaris/cosmos/x/evm/keeper"
+	evmtypes "github.com/berachain/polaris/cosmos/x/evm/types"
+	"github.com/berachain/polaris/eth/core"
+	"github.com/berachain/polaris/eth/params"
+	polarapi "github.com/berachain/polaris/eth/polar/api"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethparams "github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/trie"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// debugBackend serves the debug API from a chain.
+type debugBackend struct {
+	core.Blockchain
+}
+
+func (b debugBackend) ChainConfig() *ethparams.ChainConfig { return b.Config() }
+
+var _ = Describe("ProcessPayloadEnvelope", func() {
+	var (
+		ctx sdk.Context
+		k   *keeper.Keeper
+		bc  core.Blockchain
+	)
+
+	BeforeEach(func() {
+		ctx, k, bc = setupKeeper()
+		Expect(initGenesis(ctx, k, params.DefaultChainConfig)).To(Succeed())
+		ctx = ctx.WithBlockHeight(1)
+	})
+
+	// invalidEnvelope returns the envelope of a block that claims to use gas without having any
+	// transactions, which is told apart from other invalid blocks by its extra data.
+	invalidEnvelope := func(extra byte) *evmtypes.WrappedPayloadEnvelope {
+		blobGasUsed, excessBlobGas := uint64(0), uint64(0)
+		block := ethtypes.NewBlockWithWithdrawals(&ethtypes.Header{
+			ParentHash:       bc.CurrentBlock().Hash(),
+			Difficulty:       common.Big0,
+			Number:           big.NewInt(1),
+			GasLimit:         30_000_000,
+			GasUsed:          1,
+			Time:             bc.CurrentBlock().Time + 1,
+			BaseFee:          big.NewInt(1_000_000_000),
+			Extra:            []byte{extra},
+			BlobGasUsed:      &blobGasUsed,
+			ExcessBlobGas:    &excessBlobGas,
+			ParentBeaconRoot: &common.Hash{},
+		}, nil, nil, nil, ethtypes.Withdrawals{}, trie.NewStackTrie(nil))
+		wrapped, err := evmtypes.WrapPayload(
+			engine.BlockToExecutableData(block, big.NewInt(0), nil),
+			evmtypes.EnvelopeEncoding_ENVELOPE_ENCODING_RLP,
+		)
+		Expect(err).ToNot(HaveOccurred())
+		return wrapped
+	}
+
+	It("should retain invalid blocks as bad blocks", func() {
+		_, err := k.ProcessPayloadEnvelope(ctx, invalidEnvelope(0))
+		Expect(err).To(HaveOccurred())
+		Expect(bc.BadBlocks()).To(HaveLen(1))
+		Expect(bc.BadBlocks()[0].Extra()).To(Equal([]byte{0}))
+		Expect(bc.CurrentBlock().Number.Uint64()).To(BeZero())
+	})
+
+	It("should keep at most 10 bad blocks and serve them over the debug api", func() {
+		for i := 0; i < 12; i++ {
+			_, err := k.ProcessPayloadEnvelope(ctx, invalidEnvelope(byte(i)))
+			Expect(err).To(HaveOccurred())
+		}
+		blocks := bc.BadBlocks()
+		Expect(blocks).To(HaveLen(10))
+
+		results, err := polarapi.NewDebugAPI(debugBackend{bc}).GetBadBlocks()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(results).To(HaveLen(len(blocks)))
+		for i, result := range results {
+			Expect(result.Hash).To(Equal(blocks[i].Hash()))
+			Expect(result.Block["hash"]).To(Equal(blocks[i].Hash()))
+			Expect(result.RLP).ToNot(BeEmpty())
+		}
+	})
+})
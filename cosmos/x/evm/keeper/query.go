@@ -0,0 +1,203 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/berachain/polaris/cosmos/x/evm/types"
+	ethstate "github.com/berachain/polaris/eth/core/state"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethcore "github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/ethapi"
+)
+
+// Account implements the QueryServer interface. It returns the balance, nonce and code hash of
+// the account at the height of the query.
+func (k *Keeper) Account(
+	ctx context.Context, req *types.QueryAccountRequest,
+) (*types.QueryAccountResponse, error) {
+	addr, err := parseAddress(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	sp := k.spf.NewPluginFromContext(ctx)
+	return &types.QueryAccountResponse{
+		Balance:  sp.GetBalance(addr).String(),
+		Nonce:    sp.GetNonce(addr),
+		CodeHash: sp.GetCodeHash(addr).Hex(),
+	}, nil
+}
+
+// Code implements the QueryServer interface. It returns the code of the account at the height of
+// the query.
+func (k *Keeper) Code(
+	ctx context.Context, req *types.QueryCodeRequest,
+) (*types.QueryCodeResponse, error) {
+	addr, err := parseAddress(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryCodeResponse{
+		Code: hexutil.Encode(k.spf.NewPluginFromContext(ctx).GetCode(addr)),
+	}, nil
+}
+
+// Storage implements the QueryServer interface. It returns the value of the storage slot of the
+// account at the height of the query.
+func (k *Keeper) Storage(
+	ctx context.Context, req *types.QueryStorageRequest,
+) (*types.QueryStorageResponse, error) {
+	addr, err := parseAddress(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	key, err := hexutil.Decode(req.Key)
+	if err != nil || len(key) > common.HashLength {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid storage key %q", req.Key)
+	}
+
+	value := k.spf.NewPluginFromContext(ctx).GetState(addr, common.BytesToHash(key))
+	return &types.QueryStorageResponse{Value: value.Hex()}, nil
+}
+
+// BlockByNumber implements the QueryServer interface. It returns the block with the given
+// number, encoded as by eth_getBlockByNumber.
+func (k *Keeper) BlockByNumber(
+	_ context.Context, req *types.QueryBlockByNumberRequest,
+) (*types.QueryBlockByNumberResponse, error) {
+	block := k.chain.GetBlockByNumber(req.Number)
+	if block == nil {
+		return nil, status.Errorf(codes.NotFound, "block %d not found", req.Number)
+	}
+
+	bz, err := json.Marshal(ethapi.RPCMarshalBlock(block, true, req.FullTx, k.chain.Config()))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryBlockByNumberResponse{Block: string(bz)}, nil
+}
+
+// Receipt implements the QueryServer interface. It returns the receipt, including its logs, of
+// the transaction with the given hash.
+func (k *Keeper) Receipt(
+	_ context.Context, req *types.QueryReceiptRequest,
+) (*types.QueryReceiptResponse, error) {
+	hash, err := hexutil.Decode(req.TxHash)
+	if err != nil || len(hash) != common.HashLength {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx hash %q", req.TxHash)
+	}
+
+	lookup := k.chain.GetTransactionLookup(common.BytesToHash(hash))
+	if lookup == nil {
+		return nil, status.Errorf(codes.NotFound, "tx %s not found", req.TxHash)
+	}
+	receipts := k.chain.GetReceiptsByHash(lookup.BlockHash)
+	if uint64(len(receipts)) <= lookup.TxIndex {
+		return nil, status.Errorf(codes.NotFound, "receipt of tx %s not found", req.TxHash)
+	}
+
+	bz, err := receipts[lookup.TxIndex].MarshalJSON()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryReceiptResponse{Receipt: string(bz)}, nil
+}
+
+// Call implements the QueryServer interface. It executes the call against the state at the
+// height of the query, like eth_call, and discards its state changes.
+func (k *Keeper) Call(
+	ctx context.Context, req *types.QueryCallRequest,
+) (*types.QueryCallResponse, error) {
+	var args ethapi.TransactionArgs
+	if err := json.Unmarshal([]byte(req.Args), &args); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid call args: %v", err)
+	}
+
+	sCtx := sdk.UnwrapSDKContext(ctx)
+	header := k.chain.GetHeaderByNumber(uint64(sCtx.BlockHeight()))
+	if header == nil {
+		return nil, status.Errorf(codes.NotFound, "block %d not found", sCtx.BlockHeight())
+	}
+	msg, err := args.ToMessage(k.rpcGasCap, header.BaseFee)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// The state changes of the call are written to the branched store of the query, which is
+	// never committed.
+	statedb := ethstate.NewStateDB(k.spf.NewPluginFromContext(sCtx), k.pp)
+	evm := vm.NewEVM(
+		gethcore.NewEVMBlockContext(header, k.chain, nil), gethcore.NewEVMTxContext(msg),
+		statedb, k.chain.Config(), vm.Config{NoBaseFee: true},
+	)
+
+	// Cancel the evm once the call completes, or once it times out.
+	var cancel context.CancelFunc
+	if k.rpcEVMTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, k.rpcEVMTimeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+	go func() {
+		<-ctx.Done()
+		evm.Cancel()
+	}()
+
+	result, err := gethcore.ApplyMessage(evm, msg, new(gethcore.GasPool).AddGas(math.MaxUint64))
+	if evm.Cancelled() {
+		return nil, status.Errorf(
+			codes.DeadlineExceeded, "execution aborted (timeout = %v)", k.rpcEVMTimeout,
+		)
+	}
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp := &types.QueryCallResponse{
+		Ret:     hexutil.Encode(result.ReturnData),
+		GasUsed: result.UsedGas,
+	}
+	if result.Err != nil {
+		resp.VmError = result.Err.Error()
+	}
+	return resp, nil
+}
+
+// parseAddress parses the hex encoded address of an account.
+func parseAddress(address string) (common.Address, error) {
+	if !common.IsHexAddress(address) {
+		return common.Address{}, status.Errorf(codes.InvalidArgument, "invalid address %q", address)
+	}
+	return common.HexToAddress(address), nil
+}
@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/berachain/polaris/cosmos/x/evm/keeper"
+	evmtypes "github.com/berachain/polaris/cosmos/x/evm/types"
+	"github.com/berachain/polaris/eth/core"
+	"github.com/berachain/polaris/eth/params"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethparams "github.com/ethereum/go-ethereum/params"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Query", func() {
+	var (
+		ctx    sdk.Context
+		k      *keeper.Keeper
+		funded = common.HexToAddress("0x20f33CE90A13a4b5E7697E3544c3083B8F8A51D4")
+		empty  = common.HexToAddress("0x0000000000000000000000000000000000001234")
+	)
+
+	BeforeEach(func() {
+		ctx, k, _ = setupKeeper()
+		Expect(initGenesis(ctx, k, params.DefaultChainConfig)).To(Succeed())
+	})
+
+	It("should return accounts and their code and storage", func() {
+		account, err := k.Account(ctx, &evmtypes.QueryAccountRequest{Address: funded.Hex()})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(account.Balance).To(Equal(core.DefaultGenesis.Alloc[funded].Balance.String()))
+		Expect(account.CodeHash).To(Equal(ethtypes.EmptyCodeHash.Hex()))
+
+		beaconRoots := ethparams.BeaconRootsStorageAddress.Hex()
+		code, err := k.Code(ctx, &evmtypes.QueryCodeRequest{Address: beaconRoots})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(code.Code).To(Equal(hexutil.Encode(core.BeaconRootsCode)))
+
+		storage, err := k.Storage(ctx, &evmtypes.QueryStorageRequest{
+			Address: beaconRoots, Key: "0x01",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(storage.Value).To(Equal(common.Hash{}.Hex()))
+
+		_, err = k.Account(ctx, &evmtypes.QueryAccountRequest{Address: "0x01"})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+
+	It("should return blocks and receipts", func() {
+		gen := *core.DefaultGenesis
+		gen.Config = params.DefaultChainConfig
+		block, err := k.BlockByNumber(ctx, &evmtypes.QueryBlockByNumberRequest{Number: 0})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(block.Block).To(ContainSubstring(gen.ToBlock().Hash().Hex()))
+
+		_, err = k.BlockByNumber(ctx, &evmtypes.QueryBlockByNumberRequest{Number: 1})
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+
+		_, err = k.Receipt(ctx, &evmtypes.QueryReceiptRequest{TxHash: common.Hash{}.Hex()})
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+	})
+
+	It("should execute calls without committing them", func() {
+		sp := k.Host.GetStatePluginFactory().NewPluginFromContext(ctx)
+		balance, nonce := sp.GetBalance(funded), sp.GetNonce(funded)
+
+		args := fmt.Sprintf(`{"from":%q,"to":%q,"value":"0x1"}`, funded, empty)
+		call, err := k.Call(ctx, &evmtypes.QueryCallRequest{Args: args})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(call.GasUsed).To(Equal(ethparams.TxGas))
+		Expect(call.VmError).To(BeEmpty())
+
+		// The state at the context is unchanged by the call.
+		sp = k.Host.GetStatePluginFactory().NewPluginFromContext(ctx)
+		Expect(sp.GetBalance(funded)).To(Equal(balance))
+		Expect(sp.GetNonce(funded)).To(Equal(nonce))
+		Expect(sp.GetBalance(empty)).To(Equal(new(big.Int)))
+
+		args = fmt.Sprintf(`{"from":%q,"to":%q,"value":"0x1"}`, empty, funded)
+		_, err = k.Call(ctx, &evmtypes.QueryCallRequest{Args: args})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+})
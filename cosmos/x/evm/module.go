@@ -40,11 +40,12 @@ import (
 )
 
 // ConsensusVersion defines the current x/evm module consensus version.
-const ConsensusVersion = 1
+const ConsensusVersion = 2
 
 var (
 	_ appmodule.HasServices          = AppModule{}
 	_ appmodule.HasPrepareCheckState = AppModule{}
+	_ appmodule.HasPreBlocker        = AppModule{}
 	_ appmodule.HasEndBlocker        = AppModule{}
 	_ module.AppModule               = AppModule{}
 	_ module.AppModuleBasic          = AppModuleBasic{}
@@ -73,14 +74,23 @@ func (b AppModuleBasic) RegisterInterfaces(r cdctypes.InterfaceRegistry) {
 }
 
 // RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the evm module.
-func (AppModuleBasic) RegisterGRPCGatewayRoutes(_ client.Context, _ *gwruntime.ServeMux) {}
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(
+	clientCtx client.Context, mux *gwruntime.ServeMux,
+) {
+	if err := types.RegisterQueryServiceHandlerClient(
+		context.Background(), mux, types.NewQueryServiceClient(clientCtx),
+	); err != nil {
+		panic(err)
+	}
+}
 
 // GetTxCmd returns no root tx command for the evm module.
 func (AppModuleBasic) GetTxCmd() *cobra.Command {
 	return nil
 }
 
-// GetQueryCmd returns the root query command for the evm module.
+// GetQueryCmd returns no root query command for the evm module, whose query commands are
+// generated from the query service by autocli.
 func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 	return nil
 }
@@ -117,18 +127,31 @@ func (am AppModule) IsAppModule() {}
 // RegisterInvariants registers the evm module invariants.
 func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
 
-// RegisterServices registers module services.
+// RegisterServices registers module services and migrations.
 func (am AppModule) RegisterServices(registrar grpc.ServiceRegistrar) error {
 	types.RegisterMsgServiceServer(registrar, am.keeper)
+	types.RegisterQueryServiceServer(registrar, am.keeper)
+
+	if cfg, ok := registrar.(module.Configurator); ok {
+		m := keeper.NewMigrator(am.keeper)
+		if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
-// SetLatestQueryContext prepares the application state for a check.
+// PrepareCheckState prepares the application state for a check.
 func (am AppModule) PrepareCheckState(ctx context.Context) error {
-	return am.keeper.SetLatestQueryContext(ctx)
+	return am.keeper.PrepareCheckState(ctx)
+}
+
+// PreBlock adopts the chain config in the x/evm state before the block is executed.
+func (am AppModule) PreBlock(ctx context.Context) (appmodule.ResponsePreBlock, error) {
+	return &sdk.ResponsePreBlock{}, am.keeper.SyncChainConfig(ctx)
 }
 
 // Precommit performs precommit operations.
@@ -24,4 +24,7 @@ import "errors"
 
 var (
 	ErrBlockNotFound = errors.New("block not found, is your node pruned?")
+	// ErrPruningInAppState is returned when pruning historical data that is kept in the app
+	// state, which would make the app hash diverge between validators.
+	ErrPruningInAppState = errors.New("historical data in the app state cannot be pruned")
 )
@@ -24,6 +24,7 @@ import (
 	"fmt"
 
 	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/berachain/polaris/cosmos/x/evm/types"
 	"github.com/berachain/polaris/eth/core"
@@ -34,146 +35,195 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rlp"
 )
 
-// TODO: WHO WROTE THIS CODE THE FIRST TIME BLS FIX IT IS HORRIBLE.
-
-// StoreBlock implements `core.HistoricalPlugin`.
-func (p *plugin) StoreBlock(block *ethtypes.Block) error {
-	blockNum := block.NumberU64()
-
-	// store block hash to block number.
-	numBz := sdk.Uint64ToBigEndian(blockNum)
-	store := p.ctx.MultiStore().GetKVStore(p.storeKey)
+// prunedBlock is what is retained of a block whose body has been pruned while its receipts and
+// tx lookup entries are kept, which is enough to derive the receipts.
+type prunedBlock struct {
+	Header   *ethtypes.Header
+	TxHashes []common.Hash
+}
 
-	// store block num to block
+// storeBlock stores the block under its number and maps its hash to its number.
+func storeBlock(store storetypes.KVStore, block *ethtypes.Block) error {
 	blockBz, err := rlp.EncodeToBytes(block)
 	if err != nil {
 		return err
 	}
-	prefix.NewStore(store, []byte{types.BlockNumKeyToBlockPrefix}).
-		Set(numBz, blockBz)
-
-	// store block hash to block number.
-	prefix.NewStore(store, []byte{types.BlockHashKeyToNumPrefix}).
-		Set(block.Hash().Bytes(), numBz)
-
-	// store the version offchain for consistency.
-	offChainNum := sdk.BigEndianToUint64(store.Get([]byte{types.VersionKey}))
-	if blockNum > 0 && offChainNum != blockNum-1 {
-		panic(
-			fmt.Errorf(
-				"off-chain store's latest block number %d not synced with prev block number %d",
-				offChainNum,
-				blockNum-1,
-			),
-		)
-	}
-	store.Set([]byte{types.VersionKey}, numBz)
+
+	numBz := sdk.Uint64ToBigEndian(block.NumberU64())
+	prefix.NewStore(store, []byte{types.BlockNumKeyToBlockPrefix}).Set(numBz, blockBz)
+	prefix.NewStore(store, []byte{types.BlockHashKeyToNumPrefix}).Set(block.Hash().Bytes(), numBz)
 	return nil
 }
 
-// StoreReceipts implements `core.HistoricalPlugin`.
-func (p *plugin) StoreReceipts(blockHash common.Hash, receipts ethtypes.Receipts) error {
-	// store block hash to receipts.
+// storeReceipts stores the receipts under the block hash.
+func storeReceipts(
+	store storetypes.KVStore, blockHash common.Hash, receipts ethtypes.Receipts,
+) error {
 	receiptsBz, err := coretypes.MarshalReceipts(receipts)
 	if err != nil {
-		p.ctx.Logger().Error(
-			"UpdateOffChainStorage: failed to marshal receipts at block hash %s", blockHash.Hex(),
+		return errorslib.Wrapf(
+			err, "failed to marshal receipts at block hash %s", blockHash.Hex(),
 		)
-		return err
 	}
-	prefix.NewStore(p.ctx.MultiStore().GetKVStore(p.storeKey),
-		[]byte{types.BlockHashKeyToReceiptsPrefix}).Set(blockHash.Bytes(), receiptsBz)
-
+	prefix.NewStore(store, []byte{types.BlockHashKeyToReceiptsPrefix}).
+		Set(blockHash.Bytes(), receiptsBz)
 	return nil
 }
 
-// StoreTransactions implements `core.HistoricalPlugin`.
-func (p *plugin) StoreTransactions(
-	blockNum uint64, blockHash common.Hash, txs ethtypes.Transactions,
+// storeTransactions stores a tx lookup entry for every transaction in the block.
+func storeTransactions(
+	store storetypes.KVStore, blockNum uint64, blockHash common.Hash, txs ethtypes.Transactions,
 ) error {
-	// store all txns in the block.
-	txStore := prefix.NewStore(
-		p.ctx.MultiStore().GetKVStore(p.storeKey), []byte{types.TxHashKeyToTxPrefix},
-	)
+	txStore := prefix.NewStore(store, []byte{types.TxHashKeyToTxPrefix})
 	for txIndex, tx := range txs {
-		txLookupEntry := &coretypes.TxLookupEntry{
+		tleBz, err := (&coretypes.TxLookupEntry{
 			Tx:        tx,
 			TxIndex:   uint64(txIndex),
 			BlockHash: blockHash,
 			BlockNum:  blockNum,
-		}
-		var tleBz []byte
-		tleBz, err := txLookupEntry.MarshalBinary()
+		}).MarshalBinary()
 		if err != nil {
-			p.ctx.Logger().Error(
-				"UpdateOffChainStorage: failed to marshal tx %s at block number %d",
-				tx.Hash().Hex(), blockNum,
+			return errorslib.Wrapf(
+				err, "failed to marshal tx %s at block number %d", tx.Hash().Hex(), blockNum,
 			)
-			return err
 		}
 		txStore.Set(tx.Hash().Bytes(), tleBz)
 	}
-
 	return nil
 }
 
-// GetBlockByNumber returns the block at the given height.
-func (p *plugin) GetBlockByNumber(number uint64) (*ethtypes.Block, error) {
-	store := p.ctx.MultiStore().GetKVStore(p.storeKey)
+// maxPrunedBlocks is the maximum number of blocks that are pruned at once, so that a node that
+// enables a retention policy on an existing history prunes it over a number of blocks rather than
+// stalling the block that enables it.
+const maxPrunedBlocks = 256
+
+// historyTail returns the number of the highest pruned block, or zero if none has been pruned.
+func historyTail(store storetypes.KVStore) uint64 {
+	if tailBz := store.Get([]byte{types.HistoryTailKey}); tailBz != nil {
+		return sdk.BigEndianToUint64(tailBz)
+	}
+	return 0
+}
+
+// pruneBlock deletes the block with the given number and, unless keepReceipts is set, its
+// receipts and tx lookup entries. If they are kept, the header and tx hashes of the block are
+// retained in order to derive the receipts. The block hash to number mapping is always retained
+// so that lookups of pruned data can be told apart from unknown data.
+func pruneBlock(store storetypes.KVStore, number uint64, keepReceipts bool) error {
 	numBz := sdk.Uint64ToBigEndian(number)
-	blockBz := prefix.NewStore(store, []byte{types.BlockNumKeyToBlockPrefix}).Get(numBz)
-	block := &ethtypes.Block{}
-	err := rlp.DecodeBytes(blockBz, block)
+	store.Set([]byte{types.HistoryTailKey}, numBz)
+
+	block, err := getBlockByNumber(store, number)
 	if err != nil {
-		return nil, err
+		// The block has already been pruned, or was never stored.
+		return nil //nolint:nilerr // nothing to prune.
 	}
-	return block, nil
+	prefix.NewStore(store, []byte{types.BlockNumKeyToBlockPrefix}).Delete(numBz)
+
+	if keepReceipts {
+		pruned := &prunedBlock{Header: block.Header()}
+		for _, tx := range block.Transactions() {
+			pruned.TxHashes = append(pruned.TxHashes, tx.Hash())
+		}
+		prunedBz, err := rlp.EncodeToBytes(pruned)
+		if err != nil {
+			return err
+		}
+		prefix.NewStore(store, []byte{types.BlockNumKeyToPrunedBlockPrefix}).Set(numBz, prunedBz)
+		return nil
+	}
+
+	txStore := prefix.NewStore(store, []byte{types.TxHashKeyToTxPrefix})
+	for _, tx := range block.Transactions() {
+		txStore.Delete(tx.Hash().Bytes())
+	}
+	prefix.NewStore(store, []byte{types.BlockHashKeyToReceiptsPrefix}).
+		Delete(block.Hash().Bytes())
+	return nil
 }
 
-// GetBlockByHash returns the block at the given hash.
-func (p *plugin) GetBlockByHash(blockHash common.Hash) (*ethtypes.Block, error) {
-	store := p.ctx.MultiStore().GetKVStore(p.storeKey)
-	numBz := prefix.NewStore(
-		store, []byte{types.BlockHashKeyToNumPrefix}).Get(blockHash.Bytes())
-	if numBz == nil {
+// getBlockByNumber returns the block at the given height.
+func getBlockByNumber(store storetypes.KVStore, number uint64) (*ethtypes.Block, error) {
+	blockBz := prefix.NewStore(store, []byte{types.BlockNumKeyToBlockPrefix}).
+		Get(sdk.Uint64ToBigEndian(number))
+	if blockBz == nil {
+		if number > 0 && number <= historyTail(store) {
+			return nil, core.ErrBlockPruned
+		}
 		return nil, core.ErrBlockNotFound
 	}
 
-	blockBz := prefix.NewStore(store, []byte{types.BlockNumKeyToBlockPrefix}).Get(numBz)
 	block := &ethtypes.Block{}
+	if err := rlp.DecodeBytes(blockBz, block); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
 
-	err := rlp.DecodeBytes(blockBz, block)
+// getBlockByHash returns the block at the given hash.
+func getBlockByHash(store storetypes.KVStore, blockHash common.Hash) (*ethtypes.Block, error) {
+	number, err := getBlockNumber(store, blockHash)
 	if err != nil {
 		return nil, err
 	}
-	return block, nil
+	return getBlockByNumber(store, number)
+}
+
+// getHeaderByHash returns the header at the given hash, which is retained for blocks whose body
+// has been pruned while their receipts are kept.
+func getHeaderByHash(store storetypes.KVStore, blockHash common.Hash) (*ethtypes.Header, error) {
+	number, err := getBlockNumber(store, blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := getBlockByNumber(store, number)
+	if err == nil {
+		return block.Header(), nil
+	}
+	pruned, prunedErr := getPrunedBlock(store, number)
+	if prunedErr != nil {
+		return nil, err
+	}
+	return pruned.Header, nil
 }
 
-// GetTransactionByHash returns the transaction lookup entry with the given hash.
-func (p *plugin) GetTransactionByHash(txHash common.Hash) (*coretypes.TxLookupEntry, error) {
-	// get tx from off chain.
-	tleBz := prefix.NewStore(
-		p.ctx.MultiStore().GetKVStore(p.storeKey), []byte{types.TxHashKeyToTxPrefix}).Get(txHash.Bytes())
+// getTransactionByHash returns the transaction lookup entry with the given hash.
+func getTransactionByHash(
+	store storetypes.KVStore, txHash common.Hash,
+) (*coretypes.TxLookupEntry, error) {
+	tleBz := prefix.NewStore(store, []byte{types.TxHashKeyToTxPrefix}).Get(txHash.Bytes())
 	if tleBz == nil {
 		return nil, core.ErrTxNotFound
 	}
+
 	tle := &coretypes.TxLookupEntry{}
-	err := tle.UnmarshalBinary(tleBz)
-	if err != nil {
+	if err := tle.UnmarshalBinary(tleBz); err != nil {
 		return nil, errorslib.Wrapf(err, "failed to unmarshal tx %s", txHash.Hex())
 	}
 	return tle, nil
 }
 
-// GetReceiptsByHash returns the receipts with the given block hash.
-func (p *plugin) GetReceiptsByHash(blockHash common.Hash) (ethtypes.Receipts, error) {
-	// get receipts from off chain.
-	receiptsBz := prefix.NewStore(p.ctx.MultiStore().GetKVStore(p.storeKey),
-		[]byte{types.BlockHashKeyToReceiptsPrefix}).Get(blockHash.Bytes())
+// getReceiptsByHash returns the receipts with the given block hash, with their fields derived
+// from the block or, if its body has been pruned, from its header and tx lookup entries.
+func getReceiptsByHash(
+	store storetypes.KVStore, chainConfig *params.ChainConfig, blockHash common.Hash,
+) (ethtypes.Receipts, error) {
+	number, err := getBlockNumber(store, blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	receiptsBz := prefix.NewStore(store, []byte{types.BlockHashKeyToReceiptsPrefix}).
+		Get(blockHash.Bytes())
 	if receiptsBz == nil {
+		if _, err = getBlockByNumber(store, number); err != nil {
+			return nil, err
+		}
 		return nil, fmt.Errorf("failed to find receipts for block hash %s", blockHash.Hex())
 	}
 	receipts, err := coretypes.UnmarshalReceipts(receiptsBz)
@@ -183,10 +233,56 @@ func (p *plugin) GetReceiptsByHash(blockHash common.Hash) (ethtypes.Receipts, er
 	}
 
 	// get block to derive fields on receipts
-	block, err := p.GetBlockByHash(blockHash)
+	block, err := getBlockByNumber(store, number)
+	if err != nil {
+		if block, err = rebuildPrunedBlock(store, number); err != nil {
+			return nil, err
+		}
+	}
+
+	return coretypes.DeriveReceiptsFromBlock(chainConfig, receipts, block)
+}
+
+// getBlockNumber returns the number of the block with the given hash.
+func getBlockNumber(store storetypes.KVStore, blockHash common.Hash) (uint64, error) {
+	numBz := prefix.NewStore(store, []byte{types.BlockHashKeyToNumPrefix}).
+		Get(blockHash.Bytes())
+	if numBz == nil {
+		return 0, core.ErrBlockNotFound
+	}
+	return sdk.BigEndianToUint64(numBz), nil
+}
+
+// getPrunedBlock returns what is retained of the pruned block at the given height.
+func getPrunedBlock(store storetypes.KVStore, number uint64) (*prunedBlock, error) {
+	prunedBz := prefix.NewStore(store, []byte{types.BlockNumKeyToPrunedBlockPrefix}).
+		Get(sdk.Uint64ToBigEndian(number))
+	if prunedBz == nil {
+		return nil, core.ErrBlockPruned
+	}
+
+	pruned := &prunedBlock{}
+	if err := rlp.DecodeBytes(prunedBz, pruned); err != nil {
+		return nil, err
+	}
+	return pruned, nil
+}
+
+// rebuildPrunedBlock reassembles the block at the given height, whose body has been pruned, from
+// its retained header and tx lookup entries.
+func rebuildPrunedBlock(store storetypes.KVStore, number uint64) (*ethtypes.Block, error) {
+	pruned, err := getPrunedBlock(store, number)
 	if err != nil {
 		return nil, err
 	}
 
-	return coretypes.DeriveReceiptsFromBlock(p.chainConfig, receipts, block)
+	txs := make(ethtypes.Transactions, len(pruned.TxHashes))
+	for i, txHash := range pruned.TxHashes {
+		tle, tleErr := getTransactionByHash(store, txHash)
+		if tleErr != nil {
+			return nil, tleErr
+		}
+		txs[i] = tle.Tx
+	}
+	return ethtypes.NewBlockWithHeader(pruned.Header).WithBody(txs, nil), nil
 }
@@ -0,0 +1,163 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package historical
+
+import (
+	"context"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/dbadapter"
+	storetypes "cosmossdk.io/store/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"github.com/berachain/polaris/cosmos/x/evm/types"
+	"github.com/berachain/polaris/eth/core"
+	coretypes "github.com/berachain/polaris/eth/core/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// localDBName is the name of the node-local historical database.
+const localDBName = "historical"
+
+// localPlugin stores historical blocks, receipts and tx lookup entries in a node-local database
+// rather than in the consensus store, so that they do not affect the app hash.
+type localPlugin struct {
+	// chainConfig returns the chain configuration for the evm chain, which may change between
+	// blocks.
+	chainConfig func() *params.ChainConfig
+	// db is the node-local database that historical data is written to.
+	db dbm.DB
+}
+
+// NewLocalPlugin creates a new instance of the historical plugin that is backed by the given
+// node-local database.
+func NewLocalPlugin(chainConfig func() *params.ChainConfig, db dbm.DB) Plugin {
+	return &localPlugin{
+		chainConfig: chainConfig,
+		db:          db,
+	}
+}
+
+// OpenLocalDB opens the node-local historical database with the given backend in dir.
+func OpenLocalDB(backend, dir string) (dbm.DB, error) {
+	return dbm.NewDB(localDBName, dbm.BackendType(backend), dir)
+}
+
+// Prepare implements core.HistoricalPlugin. The node-local database is not versioned with the
+// consensus store, so there is nothing to prepare.
+func (p *localPlugin) Prepare(context.Context) {}
+
+// InitGenesis stores the genesis block.
+func (p *localPlugin) InitGenesis(_ sdk.Context, ethGen *core.Genesis) error {
+	return p.StoreBlock(ethGen.ToBlock())
+}
+
+// ExportGenesis implements plugins.HasGenesis. Historical data is not part of the genesis.
+func (p *localPlugin) ExportGenesis(sdk.Context, *core.Genesis) {}
+
+// StoreBlock implements `core.HistoricalPlugin`. Unlike the app state, the node-local database
+// is not rolled back with the chain, so blocks that are replayed are simply overwritten.
+func (p *localPlugin) StoreBlock(block *ethtypes.Block) error {
+	return p.write(func(store storetypes.KVStore) error {
+		if err := storeBlock(store, block); err != nil {
+			return err
+		}
+		store.Set([]byte{types.VersionKey}, sdk.Uint64ToBigEndian(block.NumberU64()))
+		return nil
+	})
+}
+
+// StoreReceipts implements `core.HistoricalPlugin`.
+func (p *localPlugin) StoreReceipts(blockHash common.Hash, receipts ethtypes.Receipts) error {
+	return p.write(func(store storetypes.KVStore) error {
+		return storeReceipts(store, blockHash, receipts)
+	})
+}
+
+// StoreTransactions implements `core.HistoricalPlugin`.
+func (p *localPlugin) StoreTransactions(
+	blockNum uint64, blockHash common.Hash, txs ethtypes.Transactions,
+) error {
+	return p.write(func(store storetypes.KVStore) error {
+		return storeTransactions(store, blockNum, blockHash, txs)
+	})
+}
+
+// PruneBlocks implements `core.HistoricalPlugin`. The blocks above the history tail are pruned in
+// ascending order, at most `maxPrunedBlocks` at a time, and each in its own batch, so that the
+// tail never covers blocks that have not been deleted.
+func (p *localPlugin) PruneBlocks(number uint64, keepReceipts bool) error {
+	tail := historyTail(p.store())
+	for n := tail + 1; n <= min(number, tail+maxPrunedBlocks); n++ {
+		if err := p.write(func(store storetypes.KVStore) error {
+			return pruneBlock(store, n, keepReceipts)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetBlockByNumber returns the block at the given height.
+func (p *localPlugin) GetBlockByNumber(number uint64) (*ethtypes.Block, error) {
+	return getBlockByNumber(p.store(), number)
+}
+
+// GetBlockByHash returns the block at the given hash.
+func (p *localPlugin) GetBlockByHash(blockHash common.Hash) (*ethtypes.Block, error) {
+	return getBlockByHash(p.store(), blockHash)
+}
+
+// GetHeaderByHash returns the header at the given hash.
+func (p *localPlugin) GetHeaderByHash(blockHash common.Hash) (*ethtypes.Header, error) {
+	return getHeaderByHash(p.store(), blockHash)
+}
+
+// GetTransactionByHash returns the transaction lookup entry with the given hash.
+func (p *localPlugin) GetTransactionByHash(txHash common.Hash) (*coretypes.TxLookupEntry, error) {
+	return getTransactionByHash(p.store(), txHash)
+}
+
+// GetReceiptsByHash returns the receipts with the given block hash.
+func (p *localPlugin) GetReceiptsByHash(blockHash common.Hash) (ethtypes.Receipts, error) {
+	return getReceiptsByHash(p.store(), p.chainConfig(), blockHash)
+}
+
+// store returns a KV store view of the node-local database.
+func (p *localPlugin) store() storetypes.KVStore {
+	return dbadapter.Store{DB: p.db}
+}
+
+// write applies fn to a cache of the node-local database, which is only flushed if fn succeeds.
+func (p *localPlugin) write(fn func(storetypes.KVStore) error) error {
+	cache := cachekv.NewStore(p.store())
+	if err := fn(cache); err != nil {
+		return err
+	}
+	cache.Write()
+	return nil
+}
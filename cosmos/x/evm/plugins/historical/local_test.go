@@ -0,0 +1,185 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package historical
+
+import (
+	"math/big"
+
+	"cosmossdk.io/log"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	testutil "github.com/berachain/polaris/cosmos/testutil"
+	"github.com/berachain/polaris/eth/core"
+	"github.com/berachain/polaris/eth/params"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Local Historical Data", func() {
+	var p Plugin
+
+	// storeBlock stores a block with a single transaction at the given height.
+	storeBlock := func(number int64) (*ethtypes.Block, *ethtypes.Transaction) {
+		tx := ethtypes.NewTransaction(
+			uint64(number), common.Address{0x1}, big.NewInt(1), 1000, big.NewInt(1), nil,
+		)
+		receipts := ethtypes.Receipts{
+			{
+				Status:            1,
+				CumulativeGasUsed: 500,
+				TxHash:            tx.Hash(),
+				GasUsed:           500,
+				BlockNumber:       big.NewInt(number),
+			},
+		}
+		block := ethtypes.NewBlock(
+			&ethtypes.Header{Number: big.NewInt(number), GasLimit: 1000},
+			ethtypes.Transactions{tx}, nil, receipts, trie.NewStackTrie(nil),
+		)
+		receipts[0].BlockHash = block.Hash()
+
+		Expect(p.StoreBlock(block)).To(Succeed())
+		Expect(p.StoreReceipts(block.Hash(), receipts)).To(Succeed())
+		Expect(p.StoreTransactions(uint64(number), block.Hash(), block.Transactions())).
+			To(Succeed())
+		return block, tx
+	}
+
+	BeforeEach(func() {
+		ctx := testutil.NewContext(log.NewTestLogger(GinkgoT())).WithBlockHeight(0)
+		genesis := core.DefaultGenesis
+		genesis.Config = params.DefaultChainConfig
+		p = NewLocalPlugin(chainConfig, dbm.NewMemDB())
+		Expect(p.InitGenesis(ctx, genesis)).To(Succeed())
+	})
+
+	It("should store and return blocks, receipts and txs", func() {
+		genesis, err := p.GetBlockByNumber(0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(genesis.NumberU64()).To(Equal(uint64(0)))
+
+		block, tx := storeBlock(1)
+
+		blockByHash, err := p.GetBlockByHash(block.Hash())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(blockByHash.Hash()).To(Equal(block.Hash()))
+
+		receipts, err := p.GetReceiptsByHash(block.Hash())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(receipts[0].TxHash).To(Equal(tx.Hash()))
+		Expect(receipts[0].BlockHash).To(Equal(block.Hash()))
+
+		tle, err := p.GetTransactionByHash(tx.Hash())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(tle.BlockNum).To(Equal(uint64(1)))
+		Expect(tle.BlockHash).To(Equal(block.Hash()))
+	})
+
+	It("should tolerate storing the same block twice", func() {
+		storeBlock(1)
+		block, _ := storeBlock(1)
+
+		blockByNum, err := p.GetBlockByNumber(1)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(blockByNum.Hash()).To(Equal(block.Hash()))
+	})
+
+	It("should prune blocks with their receipts and tx lookups", func() {
+		pruned, prunedTx := storeBlock(1)
+		kept, keptTx := storeBlock(2)
+		Expect(p.PruneBlocks(1, false)).To(Succeed())
+
+		_, err := p.GetBlockByNumber(1)
+		Expect(err).To(MatchError(core.ErrBlockPruned))
+		_, err = p.GetBlockByHash(pruned.Hash())
+		Expect(err).To(MatchError(core.ErrBlockPruned))
+		_, err = p.GetHeaderByHash(pruned.Hash())
+		Expect(err).To(MatchError(core.ErrBlockPruned))
+		_, err = p.GetReceiptsByHash(pruned.Hash())
+		Expect(err).To(MatchError(core.ErrBlockPruned))
+		_, err = p.GetTransactionByHash(prunedTx.Hash())
+		Expect(err).To(MatchError(core.ErrTxNotFound))
+
+		_, err = p.GetBlockByHash(kept.Hash())
+		Expect(err).ToNot(HaveOccurred())
+		_, err = p.GetTransactionByHash(keptTx.Hash())
+		Expect(err).ToNot(HaveOccurred())
+
+		// Blocks above the pruned tail are unknown rather than pruned.
+		_, err = p.GetBlockByNumber(3)
+		Expect(err).To(MatchError(core.ErrBlockNotFound))
+	})
+
+	It("should prune an existing history that predates the retention policy", func() {
+		blocks := make([]*ethtypes.Block, maxPrunedBlocks+3)
+		for i := range blocks {
+			blocks[i], _ = storeBlock(int64(i + 1))
+		}
+
+		// The blocks up to the target are pruned at most maxPrunedBlocks at a time.
+		Expect(p.PruneBlocks(maxPrunedBlocks+2, false)).To(Succeed())
+		for _, block := range blocks[:maxPrunedBlocks] {
+			_, err := p.GetBlockByNumber(block.NumberU64())
+			Expect(err).To(MatchError(core.ErrBlockPruned))
+		}
+		_, err := p.GetBlockByNumber(maxPrunedBlocks + 1)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(p.PruneBlocks(maxPrunedBlocks+2, false)).To(Succeed())
+		for _, block := range blocks[maxPrunedBlocks : maxPrunedBlocks+2] {
+			_, err = p.GetBlockByHash(block.Hash())
+			Expect(err).To(MatchError(core.ErrBlockPruned))
+		}
+		_, err = p.GetBlockByNumber(maxPrunedBlocks + 3)
+		Expect(err).ToNot(HaveOccurred())
+
+		// The genesis block is never pruned.
+		_, err = p.GetBlockByNumber(0)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should keep receipts, tx lookups and headers of pruned blocks if requested", func() {
+		pruned, prunedTx := storeBlock(1)
+		Expect(p.PruneBlocks(1, true)).To(Succeed())
+
+		_, err := p.GetBlockByHash(pruned.Hash())
+		Expect(err).To(MatchError(core.ErrBlockPruned))
+
+		header, err := p.GetHeaderByHash(pruned.Hash())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(header.Hash()).To(Equal(pruned.Hash()))
+
+		receipts, err := p.GetReceiptsByHash(pruned.Hash())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(receipts[0].TxHash).To(Equal(prunedTx.Hash()))
+		Expect(receipts[0].BlockHash).To(Equal(pruned.Hash()))
+
+		tle, err := p.GetTransactionByHash(prunedTx.Hash())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(tle.BlockNum).To(Equal(uint64(1)))
+	})
+})
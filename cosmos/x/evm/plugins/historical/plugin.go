@@ -22,14 +22,19 @@ package historical
 
 import (
 	"context"
+	"fmt"
 
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/berachain/polaris/cosmos/x/evm/plugins"
+	"github.com/berachain/polaris/cosmos/x/evm/types"
 	"github.com/berachain/polaris/eth/core"
+	coretypes "github.com/berachain/polaris/eth/core/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
 )
 
@@ -43,8 +48,9 @@ type Plugin interface {
 type plugin struct {
 	// ctx is the current block context, used for accessing current block info and kv stores.
 	ctx sdk.Context
-	// chainConfig stores the chain configuration for the evm chain.make
-	chainConfig *params.ChainConfig
+	// chainConfig returns the chain configuration for the evm chain, which may change between
+	// blocks.
+	chainConfig func() *params.ChainConfig
 	// bp represents the block plugin, used for accessing historical block headers.
 	bp core.BlockPlugin
 	// storekey is the store key for the header store.
@@ -53,7 +59,7 @@ type plugin struct {
 
 // NewPlugin creates a new instance of the block plugin from the given context.
 func NewPlugin(
-	chainConfig *params.ChainConfig, bp core.BlockPlugin,
+	chainConfig func() *params.ChainConfig, bp core.BlockPlugin,
 	_ storetypes.StoreKey, storekey storetypes.StoreKey,
 ) Plugin {
 	return &plugin{
@@ -67,3 +73,74 @@ func NewPlugin(
 func (p *plugin) Prepare(ctx context.Context) {
 	p.ctx = sdk.UnwrapSDKContext(ctx)
 }
+
+// StoreBlock implements `core.HistoricalPlugin`.
+func (p *plugin) StoreBlock(block *ethtypes.Block) error {
+	store := p.store()
+	if err := storeBlock(store, block); err != nil {
+		return err
+	}
+
+	// store the version offchain for consistency.
+	blockNum := block.NumberU64()
+	offChainNum := sdk.BigEndianToUint64(store.Get([]byte{types.VersionKey}))
+	if blockNum > 0 && offChainNum != blockNum-1 {
+		panic(
+			fmt.Errorf(
+				"off-chain store's latest block number %d not synced with prev block number %d",
+				offChainNum,
+				blockNum-1,
+			),
+		)
+	}
+	store.Set([]byte{types.VersionKey}, sdk.Uint64ToBigEndian(blockNum))
+	return nil
+}
+
+// StoreReceipts implements `core.HistoricalPlugin`.
+func (p *plugin) StoreReceipts(blockHash common.Hash, receipts ethtypes.Receipts) error {
+	return storeReceipts(p.store(), blockHash, receipts)
+}
+
+// StoreTransactions implements `core.HistoricalPlugin`.
+func (p *plugin) StoreTransactions(
+	blockNum uint64, blockHash common.Hash, txs ethtypes.Transactions,
+) error {
+	return storeTransactions(p.store(), blockNum, blockHash, txs)
+}
+
+// PruneBlocks implements `core.HistoricalPlugin`. Historical data that is kept in the app state
+// is never pruned, as the retention policy of a node is not part of consensus.
+func (p *plugin) PruneBlocks(uint64, bool) error {
+	return ErrPruningInAppState
+}
+
+// GetBlockByNumber returns the block at the given height.
+func (p *plugin) GetBlockByNumber(number uint64) (*ethtypes.Block, error) {
+	return getBlockByNumber(p.store(), number)
+}
+
+// GetBlockByHash returns the block at the given hash.
+func (p *plugin) GetBlockByHash(blockHash common.Hash) (*ethtypes.Block, error) {
+	return getBlockByHash(p.store(), blockHash)
+}
+
+// GetHeaderByHash returns the header at the given hash.
+func (p *plugin) GetHeaderByHash(blockHash common.Hash) (*ethtypes.Header, error) {
+	return getHeaderByHash(p.store(), blockHash)
+}
+
+// GetTransactionByHash returns the transaction lookup entry with the given hash.
+func (p *plugin) GetTransactionByHash(txHash common.Hash) (*coretypes.TxLookupEntry, error) {
+	return getTransactionByHash(p.store(), txHash)
+}
+
+// GetReceiptsByHash returns the receipts with the given block hash.
+func (p *plugin) GetReceiptsByHash(blockHash common.Hash) (ethtypes.Receipts, error) {
+	return getReceiptsByHash(p.store(), p.chainConfig(), blockHash)
+}
+
+// store returns the KV store of the current context that historical data is kept in.
+func (p *plugin) store() storetypes.KVStore {
+	return p.ctx.MultiStore().GetKVStore(p.storeKey)
+}
@@ -36,6 +36,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethparams "github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/trie"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -47,6 +48,11 @@ func TestHistoricalPlugin(t *testing.T) {
 	RunSpecs(t, "cosmos/x/evm/plugins/historical")
 }
 
+// chainConfig returns the chain config that the plugins under test derive receipts with.
+func chainConfig() *ethparams.ChainConfig {
+	return params.DefaultChainConfig
+}
+
 var _ = Describe("Historical Data", func() {
 	var (
 		p   *plugin
@@ -59,7 +65,7 @@ var _ = Describe("Historical Data", func() {
 
 		genesis := core.DefaultGenesis
 		genesis.Config = params.DefaultChainConfig
-		p = utils.MustGetAs[*plugin](NewPlugin(params.DefaultChainConfig, bp, nil, testutil.EvmKey))
+		p = utils.MustGetAs[*plugin](NewPlugin(chainConfig, bp, nil, testutil.EvmKey))
 		Expect(p.InitGenesis(ctx, genesis)).To(Succeed())
 	})
 
@@ -127,6 +133,12 @@ var _ = Describe("Historical Data", func() {
 			Expect(tleByHash.BlockNum).To(Equal(uint64(1)))
 			Expect(tleByHash.Tx.Hash()).To(Equal(txHash))
 		})
+
+		It("should refuse to prune blocks", func() {
+			Expect(p.PruneBlocks(0, false)).To(MatchError(ErrPruningInAppState))
+			_, err := p.GetBlockByNumber(0)
+			Expect(err).ToNot(HaveOccurred())
+		})
 	})
 
 })
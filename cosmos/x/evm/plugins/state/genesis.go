@@ -22,10 +22,12 @@ package state
 
 import (
 	"fmt"
-	"math/big"
 
 	"golang.org/x/exp/slices"
 
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/berachain/polaris/cosmos/x/evm/types"
 	"github.com/berachain/polaris/eth/core"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -35,18 +37,24 @@ import (
 
 // InitGenesis takes in a pointer to a genesis state object and populates the KV store.
 func (p *plugin) InitGenesis(ctx sdk.Context, ethGen *core.Genesis) error {
+	return p.InitGenesisAlloc(ctx, ethGen.Alloc)
+}
+
+// InitGenesisAlloc populates the KV store with the accounts of the given genesis alloc, which
+// may be one of several chunks of the alloc.
+func (p *plugin) InitGenesisAlloc(ctx sdk.Context, alloc core.GenesisAlloc) error {
 	p.Reset(ctx)
 
 	// Sort the addresses so that they are in a consistent order.
-	sortedAddresses := make([]common.Address, 0, len(ethGen.Alloc))
-	for address := range ethGen.Alloc {
+	sortedAddresses := make([]common.Address, 0, len(alloc))
+	for address := range alloc {
 		sortedAddresses = append(sortedAddresses, address)
 	}
 	slices.SortStableFunc(sortedAddresses, func(a, b common.Address) int { return a.Cmp(b) })
 
 	// Iterate over the sorted genesis accounts and set nonces, balances, codes, and storage.
 	for _, address := range sortedAddresses {
-		account := ethGen.Alloc[address]
+		account := alloc[address]
 
 		// Initialize the account on the auth keeper.
 		// NOTE: The auth module's init genesis runs before the evm module's init genesis.
@@ -86,57 +94,77 @@ func (p *plugin) InitGenesis(ctx sdk.Context, ethGen *core.Genesis) error {
 
 // Export genesis modifies a pointer to a genesis state object and populates it.
 func (p *plugin) ExportGenesis(ctx sdk.Context, ethGen *core.Genesis) {
-	p.Reset(ctx)
 	ethGen.Alloc = make(core.GenesisAlloc)
-
-	// Iterate Balances and set the genesis accounts.
-	p.IterateBalances(func(address common.Address, balance *big.Int) bool {
-		account, ok := ethGen.Alloc[address]
-		if !ok {
-			account = core.GenesisAccount{}
-		}
-		account.Code = p.GetCode(address)
-		if account.Code != nil {
-			account.Storage = make(map[common.Hash]common.Hash)
-		}
-		account.Balance = p.GetBalance(address)
-		account.Nonce = p.GetNonce(address)
+	// The alloc is held in memory, the state of a large chain is exported in chunks instead.
+	_ = p.ExportGenesisAlloc(ctx, func(address common.Address, account core.GenesisAccount) error {
 		ethGen.Alloc[address] = account
-		return false
+		return nil
 	})
+}
 
-	// Iterate Storage and set the genesis accounts.
-	p.IterateState(func(address common.Address, key common.Hash, value common.Hash) bool {
-		account, ok := ethGen.Alloc[address]
-		if !ok {
-			account = core.GenesisAccount{}
-		}
-		if account.Storage == nil {
-			account.Storage = make(map[common.Hash]common.Hash)
+// ExportGenesisAlloc calls fn with each account that has a balance, code or storage, in
+// ascending order of address, without holding the genesis alloc in memory. It stops at the first
+// error returned by fn.
+func (p *plugin) ExportGenesisAlloc(
+	ctx sdk.Context, fn func(common.Address, core.GenesisAccount) error,
+) error {
+	p.Reset(ctx)
+	store := p.cms.GetKVStore(p.storeKey)
+
+	// The keys of balances, code hashes and storage slots are prefixed by the address, so the
+	// accounts are found by merging their iterators.
+	balances := storetypes.KVStorePrefixIterator(store, []byte{types.BalanceKeyPrefix})
+	codeHashes := storetypes.KVStorePrefixIterator(store, []byte{types.CodeHashKeyPrefix})
+	slots := storetypes.KVStorePrefixIterator(store, []byte{types.StorageKeyPrefix})
+	its := []struct {
+		storetypes.Iterator
+		address func([]byte) common.Address
+	}{
+		{balances, AddressFromBalanceKey},
+		{codeHashes, AddressFromCodeHashKey},
+		{slots, AddressFromSlotKey},
+	}
+	defer func() {
+		for _, it := range its {
+			if err := it.Close(); err != nil {
+				p.dbErr = err
+			}
 		}
-		account.Storage[key] = value
+	}()
 
-		account.Code = p.GetCode(address)
-		account.Balance = p.GetBalance(address)
-		account.Nonce = p.GetNonce(address)
-		ethGen.Alloc[address] = account
-
-		return false
-	})
+	for {
+		var address *common.Address
+		for _, it := range its {
+			if !it.Valid() {
+				continue
+			}
+			if next := it.address(it.Key()); address == nil || next.Cmp(*address) < 0 {
+				address = &next
+			}
+		}
+		if address == nil {
+			return nil
+		}
 
-	// Iterate Code and set the genesis accounts.
-	p.IterateCode(func(address common.Address, codeHash common.Hash) bool {
-		account, ok := ethGen.Alloc[address]
-		if !ok {
-			account = core.GenesisAccount{}
+		account := core.GenesisAccount{
+			Balance: p.GetBalance(*address),
+			Nonce:   p.GetNonce(*address),
+			Code:    p.GetCode(*address),
 		}
-		account.Code = p.GetCode(address)
-		account.Nonce = p.GetNonce(address)
-		if account.Balance == nil {
-			account.Balance = big.NewInt(0)
+		for ; slots.Valid() && AddressFromSlotKey(slots.Key()) == *address; slots.Next() {
+			if account.Storage == nil {
+				account.Storage = make(map[common.Hash]common.Hash)
+			}
+			account.Storage[SlotFromSlotKey(slots.Key())] = common.BytesToHash(slots.Value())
+		}
+		for _, it := range its {
+			for it.Valid() && it.address(it.Key()) == *address {
+				it.Next()
+			}
 		}
-		ethGen.Alloc[address] = account
 
-		return false
-	})
+		if err := fn(*address, account); err != nil {
+			return err
+		}
+	}
 }
@@ -60,6 +60,11 @@ type Plugin interface {
 	IterateBalances(fn func(common.Address, *big.Int) bool)
 	// IterateState iterates over the state of all accounts and calls the callback function.
 	IterateState(fn func(addr common.Address, key common.Hash, value common.Hash) bool)
+	// InitGenesisAlloc populates the state with the accounts of a chunk of the genesis alloc.
+	InitGenesisAlloc(ctx sdk.Context, alloc core.GenesisAlloc) error
+	// ExportGenesisAlloc calls the callback function with every account, in ascending order of
+	// address.
+	ExportGenesisAlloc(ctx sdk.Context, fn func(common.Address, core.GenesisAccount) error) error
 	// SetGasConfig sets the gas config for the plugin.
 	SetGasConfig(storetypes.GasConfig, storetypes.GasConfig)
 	// SetPrecompileLogFactory sets the precompile log factory for the plugin.
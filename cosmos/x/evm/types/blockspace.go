@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BasisPoints is the denominator of the block space shares in the params.
+const BasisPoints = 10_000
+
+// ErrBlockSpaceExceeded is returned when a lane of a block uses more space than it is allowed to.
+var ErrBlockSpaceExceeded = errors.New("block space exceeded")
+
+// BlockSpace partitions the byte and gas limits of a block between the payload envelope and the
+// Cosmos transactions included alongside it. The envelope may use the limits minus the reserved
+// share of the Cosmos transactions, which in turn may use whatever the envelope leaves over. All
+// gas is expressed in Cosmos gas, into which the gas used by the envelope is converted.
+type BlockSpace struct {
+	// MaxBytes is the byte limit of the transactions of the block.
+	MaxBytes uint64
+	// MaxGas is the gas limit of the block, which is math.MaxUint64 if it is unlimited.
+	MaxGas uint64
+	// EVMMaxBytes is the byte limit of the payload envelope.
+	EVMMaxBytes uint64
+	// EVMMaxGas is the gas limit of the payload envelope.
+	EVMMaxGas uint64
+
+	// params convert the gas used by the payload envelope.
+	params Params
+}
+
+// BlockSpace returns the partitioning of a block with the given byte and gas limits, as found in
+// the consensus params. A negative max gas means that the block gas is unlimited, in which case
+// no gas is reserved.
+func (p Params) BlockSpace(maxBytes, maxGas int64) BlockSpace {
+	bs := BlockSpace{MaxGas: math.MaxUint64, EVMMaxGas: math.MaxUint64, params: p}
+	if maxBytes > 0 {
+		bs.MaxBytes = uint64(maxBytes)
+	}
+	bs.EVMMaxBytes = bs.MaxBytes - reserved(bs.MaxBytes, p.CosmosBytesReserveBps)
+	if maxGas >= 0 {
+		bs.MaxGas = uint64(maxGas)
+		bs.EVMMaxGas = bs.MaxGas - reserved(bs.MaxGas, p.CosmosGasReserveBps)
+	}
+	return bs
+}
+
+// ConsensusBlockSpace returns the partitioning of a block with the byte and gas limits of the
+// consensus params at the given context. Proposers and verifiers must both partition this space,
+// so that every proposal that is built is accepted.
+func (p Params) ConsensusBlockSpace(ctx sdk.Context) BlockSpace {
+	maxBytes, maxGas := int64(cmttypes.MaxBlockSizeBytes), int64(-1)
+	if b := ctx.ConsensusParams().Block; b != nil {
+		if b.MaxBytes > 0 {
+			maxBytes = b.MaxBytes
+		}
+		maxGas = b.MaxGas
+	}
+	return p.BlockSpace(maxBytes, maxGas)
+}
+
+// LimitTxBytes returns the block space with both lanes further limited to the given max bytes of
+// the transactions of a proposal, which CometBFT derives from the max bytes of the block minus
+// its header, commit and evidence. The limited space is a subset of the block space, so it
+// always passes verification against the latter.
+func (bs BlockSpace) LimitTxBytes(maxTxBytes int64) BlockSpace {
+	if maxTxBytes >= 0 && uint64(maxTxBytes) < bs.MaxBytes {
+		bs.MaxBytes = uint64(maxTxBytes)
+		bs.EVMMaxBytes = min(bs.EVMMaxBytes, bs.MaxBytes)
+	}
+	return bs
+}
+
+// CosmosSpace returns the bytes and gas left over for the Cosmos transactions of a block whose
+// payload envelope uses the given bytes and evm gas.
+func (bs BlockSpace) CosmosSpace(evmBytes, evmGas uint64) (uint64, uint64) {
+	return remaining(bs.MaxBytes, evmBytes), remaining(bs.MaxGas, bs.params.EVMGasToCosmos(evmGas))
+}
+
+// VerifyEVM checks that a payload envelope of the given bytes and evm gas fits within its lane.
+func (bs BlockSpace) VerifyEVM(evmBytes, evmGas uint64) error {
+	if evmBytes > bs.EVMMaxBytes {
+		return fmt.Errorf(
+			"%w: envelope of %d bytes, limit %d", ErrBlockSpaceExceeded, evmBytes, bs.EVMMaxBytes,
+		)
+	}
+	if gas := bs.params.EVMGasToCosmos(evmGas); gas > bs.EVMMaxGas {
+		return fmt.Errorf(
+			"%w: envelope of %d gas, limit %d", ErrBlockSpaceExceeded, gas, bs.EVMMaxGas,
+		)
+	}
+	return nil
+}
+
+// Verify checks that a block with a payload envelope of the given bytes and evm gas and Cosmos
+// transactions of the given bytes and gas respects the partitioning.
+func (bs BlockSpace) Verify(evmBytes, evmGas, cosmosBytes, cosmosGas uint64) error {
+	if err := bs.VerifyEVM(evmBytes, evmGas); err != nil {
+		return err
+	}
+	maxBytes, maxGas := bs.CosmosSpace(evmBytes, evmGas)
+	if cosmosBytes > maxBytes {
+		return fmt.Errorf(
+			"%w: cosmos txs of %d bytes, limit %d", ErrBlockSpaceExceeded, cosmosBytes, maxBytes,
+		)
+	}
+	if cosmosGas > maxGas {
+		return fmt.Errorf(
+			"%w: cosmos txs of %d gas, limit %d", ErrBlockSpaceExceeded, cosmosGas, maxGas,
+		)
+	}
+	return nil
+}
+
+// TxsSize returns the size of the given transactions as accounted for against the byte limit of
+// a block, which includes their protobuf framing.
+func TxsSize(txs ...[]byte) uint64 {
+	cmtTxs := make([]cmttypes.Tx, len(txs))
+	for i, tx := range txs {
+		cmtTxs[i] = tx
+	}
+	return uint64(cmttypes.ComputeProtoSizeForTxs(cmtTxs))
+}
+
+// reserved returns the share in basis points of the given limit, rounded up.
+func reserved(limit uint64, bps uint32) uint64 {
+	if bps >= BasisPoints {
+		return limit
+	}
+	// Split the limit to avoid overflowing on large limits.
+	quo, rem := limit/BasisPoints, limit%BasisPoints
+	return quo*uint64(bps) + (rem*uint64(bps)+BasisPoints-1)/BasisPoints
+}
+
+// remaining returns the limit minus the used amount, or zero if the limit has been exceeded.
+func remaining(limit, used uint64) uint64 {
+	if used > limit {
+		return 0
+	}
+	return limit - used
+}
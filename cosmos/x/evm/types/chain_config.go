@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/params"
+)
+
+var (
+	// ErrInvalidChainConfig is returned when a chain config cannot be decoded or scheduled.
+	ErrInvalidChainConfig = errors.New("invalid chain config")
+	// ErrChainConfigConflict is returned when the local chain config of a node sets a value that
+	// differs from the chain config in the x/evm state.
+	ErrChainConfigConflict = errors.New("local chain config conflicts with the on-chain config")
+)
+
+// MarshalChainConfig validates the fork order of the chain config and encodes it.
+func MarshalChainConfig(cfg *params.ChainConfig) ([]byte, error) {
+	if cfg == nil || cfg.ChainID == nil {
+		return nil, fmt.Errorf("%w: missing chain id", ErrInvalidChainConfig)
+	}
+	if err := cfg.CheckConfigForkOrder(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidChainConfig, err)
+	}
+	return json.Marshal(cfg)
+}
+
+// UnmarshalChainConfig decodes and validates the fork order of the chain config.
+func UnmarshalChainConfig(bz []byte) (*params.ChainConfig, error) {
+	cfg := new(params.ChainConfig)
+	if err := json.Unmarshal(bz, cfg); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidChainConfig, err)
+	}
+	if _, err := MarshalChainConfig(cfg); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// VerifyLocalChainConfig returns an error if a value that is set in the local chain config
+// differs from the value in the on-chain chain config. Values that are unset locally, which
+// are nil forks and false flags, defer to the on-chain chain config.
+func VerifyLocalChainConfig(local, onChain *params.ChainConfig) error {
+	localFields, err := chainConfigFields(local)
+	if err != nil {
+		return err
+	}
+	onChainFields, err := chainConfigFields(onChain)
+	if err != nil {
+		return err
+	}
+
+	for name, value := range localFields {
+		if bytes.Equal(value, []byte("null")) {
+			continue
+		}
+		if !bytes.Equal(value, onChainFields[name]) {
+			return fmt.Errorf(
+				"%w: %s is %s locally and %s on-chain",
+				ErrChainConfigConflict, name, value, onChainFields[name],
+			)
+		}
+	}
+	return nil
+}
+
+// VerifyChainConfigUpdate returns an error if the updated chain config changes the chain id or
+// changes a fork that is active at the given block height and time. Forks can therefore only
+// be scheduled for, or moved between, future blocks.
+func VerifyChainConfigUpdate(current, updated *params.ChainConfig, height, time uint64) error {
+	if _, err := MarshalChainConfig(updated); err != nil {
+		return err
+	}
+	if current.ChainID.Cmp(updated.ChainID) != 0 {
+		return fmt.Errorf(
+			"%w: chain id cannot change from %s to %s",
+			ErrInvalidChainConfig, current.ChainID, updated.ChainID,
+		)
+	}
+	if compatErr := current.CheckCompatible(updated, height, time); compatErr != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidChainConfig, compatErr)
+	}
+	return nil
+}
+
+// chainConfigFields returns the JSON encoded fields of the chain config by name.
+func chainConfigFields(cfg *params.ChainConfig) (map[string]json.RawMessage, error) {
+	bz, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err = json.Unmarshal(bz, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
@@ -32,6 +32,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&WrappedEthereumTransaction{},
 		&WrappedPayloadEnvelope{},
+		&MsgUpdateParams{},
+		&MsgUpdateChainConfig{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_MsgService_serviceDesc)
@@ -0,0 +1,252 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// maxEnvelopeSize is the maximum size of a decompressed payload envelope, which bounds the memory
+// spent on decompressing envelopes well above the size of any envelope that fits in a block.
+const maxEnvelopeSize = cmttypes.MaxBlockSizeBytes
+
+// legacyEnvelopePrefix is the first byte of a legacy JSON encoded envelope. Binary encoded
+// envelopes start with their EnvelopeEncoding instead.
+const legacyEnvelopePrefix = '{'
+
+var (
+	// ErrInvalidEnvelope is returned when the data of a WrappedPayloadEnvelope does not decode.
+	ErrInvalidEnvelope = errors.New("invalid payload envelope encoding")
+
+	// zstdEncoder and zstdDecoder are safe for concurrent use through EncodeAll and DecodeAll.
+	zstdEncoder = mustNewZstdEncoder()
+	zstdDecoder = mustNewZstdDecoder()
+)
+
+// rlpEnvelope is the RLP encoding of an execution payload envelope. The optional fields are
+// encoded as lists of at most one element, which tells them apart from their zero values as these
+// are part of the block hash.
+type rlpEnvelope struct {
+	ParentHash    common.Hash
+	FeeRecipient  common.Address
+	StateRoot     common.Hash
+	ReceiptsRoot  common.Hash
+	LogsBloom     []byte
+	Random        common.Hash
+	Number        uint64
+	GasLimit      uint64
+	GasUsed       uint64
+	Timestamp     uint64
+	ExtraData     []byte
+	BaseFeePerGas []big.Int
+	BlockHash     common.Hash
+	Transactions  [][]byte
+	Withdrawals   []ethtypes.Withdrawals
+	BlobGasUsed   []uint64
+	ExcessBlobGas []uint64
+	BlockValue    []big.Int
+	BlobsBundle   []engine.BlobsBundleV1
+	Override      bool
+}
+
+// EncodeEnvelope encodes an execution payload envelope with the given encoding.
+func EncodeEnvelope(
+	envelope *engine.ExecutionPayloadEnvelope, encoding EnvelopeEncoding,
+) ([]byte, error) {
+	if encoding == EnvelopeEncoding_ENVELOPE_ENCODING_JSON {
+		return envelope.MarshalJSON()
+	}
+
+	payload := envelope.ExecutionPayload
+	if payload == nil {
+		return nil, errors.New("envelope is missing execution payload")
+	}
+	enc := &rlpEnvelope{
+		ParentHash:    payload.ParentHash,
+		FeeRecipient:  payload.FeeRecipient,
+		StateRoot:     payload.StateRoot,
+		ReceiptsRoot:  payload.ReceiptsRoot,
+		LogsBloom:     payload.LogsBloom,
+		Random:        payload.Random,
+		Number:        payload.Number,
+		GasLimit:      payload.GasLimit,
+		GasUsed:       payload.GasUsed,
+		Timestamp:     payload.Timestamp,
+		ExtraData:     payload.ExtraData,
+		BaseFeePerGas: optional(payload.BaseFeePerGas),
+		BlockHash:     payload.BlockHash,
+		Transactions:  payload.Transactions,
+		BlobGasUsed:   optional(payload.BlobGasUsed),
+		ExcessBlobGas: optional(payload.ExcessBlobGas),
+		BlockValue:    optional(envelope.BlockValue),
+		BlobsBundle:   optional(envelope.BlobsBundle),
+		Override:      envelope.Override,
+	}
+	if payload.Withdrawals != nil {
+		enc.Withdrawals = []ethtypes.Withdrawals{payload.Withdrawals}
+	}
+	bz, err := rlp.EncodeToBytes(enc)
+	if err != nil {
+		return nil, err
+	}
+
+	switch encoding {
+	case EnvelopeEncoding_ENVELOPE_ENCODING_RLP:
+	case EnvelopeEncoding_ENVELOPE_ENCODING_RLP_SNAPPY:
+		bz = snappy.Encode(nil, bz)
+	case EnvelopeEncoding_ENVELOPE_ENCODING_RLP_ZSTD:
+		bz = zstdEncoder.EncodeAll(bz, nil)
+	default:
+		return nil, fmt.Errorf("unknown envelope encoding %d", encoding)
+	}
+	return append([]byte{byte(encoding)}, bz...), nil
+}
+
+// DecodeEnvelope decodes an execution payload envelope of any encoding, which is determined by
+// its first byte.
+func DecodeEnvelope(bz []byte) (*engine.ExecutionPayloadEnvelope, error) {
+	if len(bz) == 0 {
+		return nil, fmt.Errorf("%w: empty data", ErrInvalidEnvelope)
+	}
+
+	var (
+		envelope = new(engine.ExecutionPayloadEnvelope)
+		encoding = EnvelopeEncoding(bz[0])
+		data     = bz[1:]
+		err      error
+	)
+	switch encoding {
+	case legacyEnvelopePrefix:
+		if err = envelope.UnmarshalJSON(bz); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidEnvelope, err)
+		}
+		return envelope, nil
+	case EnvelopeEncoding_ENVELOPE_ENCODING_RLP:
+	case EnvelopeEncoding_ENVELOPE_ENCODING_RLP_SNAPPY:
+		var n int
+		if n, err = snappy.DecodedLen(data); err == nil && n > maxEnvelopeSize {
+			err = fmt.Errorf("decoded size %d exceeds %d", n, maxEnvelopeSize)
+		}
+		if err == nil {
+			data, err = snappy.Decode(nil, data)
+		}
+	case EnvelopeEncoding_ENVELOPE_ENCODING_RLP_ZSTD:
+		data, err = zstdDecoder.DecodeAll(data, nil)
+	default:
+		return nil, fmt.Errorf("%w: unknown encoding %d", ErrInvalidEnvelope, bz[0])
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidEnvelope, err)
+	}
+
+	var dec rlpEnvelope
+	if err = rlp.DecodeBytes(data, &dec); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidEnvelope, err)
+	}
+	payload := &engine.ExecutableData{
+		ParentHash:   dec.ParentHash,
+		FeeRecipient: dec.FeeRecipient,
+		StateRoot:    dec.StateRoot,
+		ReceiptsRoot: dec.ReceiptsRoot,
+		LogsBloom:    dec.LogsBloom,
+		Random:       dec.Random,
+		Number:       dec.Number,
+		GasLimit:     dec.GasLimit,
+		GasUsed:      dec.GasUsed,
+		Timestamp:    dec.Timestamp,
+		ExtraData:    dec.ExtraData,
+		BlockHash:    dec.BlockHash,
+		Transactions: dec.Transactions,
+	}
+	if payload.BaseFeePerGas, err = fromOptional(dec.BaseFeePerGas); err != nil {
+		return nil, err
+	}
+	if payload.BlobGasUsed, err = fromOptional(dec.BlobGasUsed); err != nil {
+		return nil, err
+	}
+	if payload.ExcessBlobGas, err = fromOptional(dec.ExcessBlobGas); err != nil {
+		return nil, err
+	}
+	if withdrawals, wErr := fromOptional(dec.Withdrawals); wErr != nil {
+		return nil, wErr
+	} else if withdrawals != nil {
+		payload.Withdrawals = make(ethtypes.Withdrawals, 0, len(*withdrawals))
+		payload.Withdrawals = append(payload.Withdrawals, *withdrawals...)
+	}
+
+	envelope.ExecutionPayload = payload
+	envelope.Override = dec.Override
+	if envelope.BlockValue, err = fromOptional(dec.BlockValue); err != nil {
+		return nil, err
+	}
+	if envelope.BlobsBundle, err = fromOptional(dec.BlobsBundle); err != nil {
+		return nil, err
+	}
+	return envelope, nil
+}
+
+// optional encodes an optional value as a list of at most one element.
+func optional[T any](v *T) []T {
+	if v == nil {
+		return nil
+	}
+	return []T{*v}
+}
+
+// fromOptional decodes an optional value from a list of at most one element.
+func fromOptional[T any](vs []T) (*T, error) {
+	switch len(vs) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &vs[0], nil
+	default:
+		return nil, fmt.Errorf("%w: optional field of %d elements", ErrInvalidEnvelope, len(vs))
+	}
+}
+
+func mustNewZstdEncoder() *zstd.Encoder {
+	encoder, err := zstd.NewWriter(nil)
+	if err != nil {
+		panic(err)
+	}
+	return encoder
+}
+
+func mustNewZstdDecoder() *zstd.Decoder {
+	decoder, err := zstd.NewReader(nil, zstd.WithDecoderMaxMemory(maxEnvelopeSize))
+	if err != nil {
+		panic(err)
+	}
+	return decoder
+}
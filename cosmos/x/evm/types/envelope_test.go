@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/berachain/polaris/cosmos/x/evm/types"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestTypes(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/x/evm/types")
+}
+
+var _ = Describe("Envelope encoding", func() {
+	var (
+		envelope *engine.ExecutionPayloadEnvelope
+		binary   = []types.EnvelopeEncoding{
+			types.EnvelopeEncoding_ENVELOPE_ENCODING_RLP,
+			types.EnvelopeEncoding_ENVELOPE_ENCODING_RLP_SNAPPY,
+			types.EnvelopeEncoding_ENVELOPE_ENCODING_RLP_ZSTD,
+		}
+	)
+
+	BeforeEach(func() {
+		blobGasUsed := uint64(0)
+		envelope = &engine.ExecutionPayloadEnvelope{
+			ExecutionPayload: &engine.ExecutableData{
+				ParentHash:    common.Hash{0x01},
+				FeeRecipient:  common.Address{0x02},
+				LogsBloom:     make([]byte, ethtypes.BloomByteLength),
+				Number:        7,
+				GasLimit:      30_000_000,
+				GasUsed:       21_000,
+				Timestamp:     1700000000,
+				ExtraData:     []byte{},
+				BaseFeePerGas: big.NewInt(1_000_000_000),
+				BlockHash:     common.Hash{0x03},
+				Transactions:  [][]byte{make([]byte, 512), make([]byte, 512)},
+				Withdrawals:   ethtypes.Withdrawals{},
+				BlobGasUsed:   &blobGasUsed,
+			},
+			BlockValue: big.NewInt(42),
+			BlobsBundle: &engine.BlobsBundleV1{
+				Commitments: []hexutil.Bytes{{0x04}},
+				Proofs:      []hexutil.Bytes{{0x05}},
+				Blobs:       []hexutil.Bytes{{0x06}},
+			},
+		}
+	})
+
+	It("should round trip every encoding", func() {
+		expected, err := envelope.MarshalJSON()
+		Expect(err).ToNot(HaveOccurred())
+		for _, encoding := range append(binary, types.EnvelopeEncoding_ENVELOPE_ENCODING_JSON) {
+			bz, err := types.EncodeEnvelope(envelope, encoding)
+			Expect(err).ToNot(HaveOccurred())
+			decoded, err := types.DecodeEnvelope(bz)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(decoded.MarshalJSON()).To(MatchJSON(expected), encoding.String())
+		}
+	})
+
+	It("should tell absent fields apart from zero values", func() {
+		envelope.ExecutionPayload.Withdrawals = nil
+		envelope.ExecutionPayload.BlobGasUsed = nil
+		envelope.BlobsBundle = nil
+		for _, encoding := range binary {
+			bz, err := types.EncodeEnvelope(envelope, encoding)
+			Expect(err).ToNot(HaveOccurred())
+			decoded, err := types.DecodeEnvelope(bz)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(decoded.ExecutionPayload.Withdrawals).To(BeNil())
+			Expect(decoded.ExecutionPayload.BlobGasUsed).To(BeNil())
+			Expect(decoded.ExecutionPayload.ExcessBlobGas).To(BeNil())
+			Expect(decoded.BlobsBundle).To(BeNil())
+		}
+	})
+
+	It("should be smaller than the legacy encoding", func() {
+		legacy, err := types.EncodeEnvelope(envelope, types.EnvelopeEncoding_ENVELOPE_ENCODING_JSON)
+		Expect(err).ToNot(HaveOccurred())
+		for _, encoding := range binary {
+			bz, err := types.EncodeEnvelope(envelope, encoding)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(bz)).To(BeNumerically("<", len(legacy)/2), encoding.String())
+		}
+	})
+
+	It("should reject malformed envelopes", func() {
+		bz, err := types.EncodeEnvelope(envelope, types.EnvelopeEncoding_ENVELOPE_ENCODING_RLP)
+		Expect(err).ToNot(HaveOccurred())
+
+		for _, malformed := range [][]byte{
+			nil,
+			{0x7f},
+			bz[:len(bz)/2],
+			append([]byte{byte(types.EnvelopeEncoding_ENVELOPE_ENCODING_RLP_SNAPPY)}, bz[1:]...),
+			append([]byte{byte(types.EnvelopeEncoding_ENVELOPE_ENCODING_RLP_ZSTD)}, bz[1:]...),
+		} {
+			_, err = types.DecodeEnvelope(malformed)
+			Expect(err).To(MatchError(types.ErrInvalidEnvelope))
+		}
+	})
+})
@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/berachain/polaris/eth/core"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// ErrInvalidGenesis is returned when the evm genesis is invalid.
+var ErrInvalidGenesis = errors.New("invalid evm genesis")
+
+// ValidateGenesis validates the chain config, the block values and the alloc of the Ethereum
+// genesis. Alloc accounts may not be placed at the Ethereum precompiles, nor at the given
+// precompiles registered by the chain.
+func ValidateGenesis(ethGen *core.Genesis, precompiles ...common.Address) error {
+	// A genesis without a chain config is seeded with the local chain config.
+	if ethGen.Config != nil {
+		if _, err := MarshalChainConfig(ethGen.Config); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidGenesis, err)
+		}
+	}
+
+	if ethGen.GasLimit < params.MinGasLimit || ethGen.GasLimit > params.MaxGasLimit {
+		return fmt.Errorf(
+			"%w: gas limit %d is out of bounds [%d, %d]",
+			ErrInvalidGenesis, ethGen.GasLimit, params.MinGasLimit, params.MaxGasLimit,
+		)
+	}
+	if ethGen.BaseFee != nil && ethGen.BaseFee.Sign() <= 0 {
+		return fmt.Errorf("%w: base fee %s must be positive", ErrInvalidGenesis, ethGen.BaseFee)
+	}
+
+	validateAccount := NewGenesisAccountValidator(precompiles...)
+	for addr, account := range ethGen.Alloc {
+		if err := validateAccount(addr, account); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewGenesisAccountValidator returns a function that validates an account of the genesis alloc.
+// Accounts may not be placed at the Ethereum precompiles, nor at the given precompiles.
+func NewGenesisAccountValidator(
+	precompiles ...common.Address,
+) func(common.Address, core.GenesisAccount) error {
+	// Precompiles of every fork are reserved, as an account placed at a precompile would be
+	// shadowed once its fork activates.
+	reserved := make(map[common.Address]struct{})
+	for _, addrs := range [][]common.Address{vm.PrecompiledAddressesCancun, precompiles} {
+		for _, addr := range addrs {
+			reserved[addr] = struct{}{}
+		}
+	}
+
+	return func(addr common.Address, account core.GenesisAccount) error {
+		if _, ok := reserved[addr]; ok {
+			return fmt.Errorf("%w: account %s is a precompile", ErrInvalidGenesis, addr.Hex())
+		}
+		if account.Balance != nil && account.Balance.Sign() < 0 {
+			return fmt.Errorf("%w: account %s has a negative balance", ErrInvalidGenesis, addr.Hex())
+		}
+		if len(account.Storage) > 0 && len(account.Code) == 0 {
+			return fmt.Errorf("%w: account %s has storage but no code", ErrInvalidGenesis, addr.Hex())
+		}
+		return nil
+	}
+}
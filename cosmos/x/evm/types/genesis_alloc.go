@@ -0,0 +1,213 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/berachain/polaris/eth/core"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ErrInvalidGenesisAllocChunk is returned when a chunk of the genesis alloc is malformed or does
+// not match its checksum.
+var ErrInvalidGenesisAllocChunk = errors.New("invalid genesis alloc chunk")
+
+// GenesisAllocChunk references a file that holds a chunk of the genesis alloc, which is exported
+// in chunks when the state is too large to be held in memory.
+type GenesisAllocChunk struct {
+	// File is the name of the chunk file in the genesis alloc directory.
+	File string `json:"file"`
+	// Accounts is the number of accounts in the chunk file.
+	Accounts uint64 `json:"accounts"`
+	// SHA256 is the hex encoded SHA-256 checksum of the chunk file.
+	SHA256 string `json:"sha256"`
+}
+
+// genesisAllocEntry is an account of a chunk file, which holds one entry per line of JSON.
+type genesisAllocEntry struct {
+	Address common.Address      `json:"address"`
+	Account core.GenesisAccount `json:"account"`
+}
+
+// ValidateGenesisAllocChunks validates the references to the chunks of the genesis alloc. The
+// chunk files themselves are verified when they are imported.
+func ValidateGenesisAllocChunks(chunks []GenesisAllocChunk) error {
+	seen := make(map[string]struct{}, len(chunks))
+	for _, chunk := range chunks {
+		if chunk.File != filepath.Base(chunk.File) || chunk.File == "." || chunk.File == ".." {
+			return fmt.Errorf("%w: invalid file name %q", ErrInvalidGenesisAllocChunk, chunk.File)
+		}
+		if _, ok := seen[chunk.File]; ok {
+			return fmt.Errorf("%w: duplicate file %s", ErrInvalidGenesisAllocChunk, chunk.File)
+		}
+		seen[chunk.File] = struct{}{}
+
+		if chunk.Accounts == 0 {
+			return fmt.Errorf("%w: file %s has no accounts", ErrInvalidGenesisAllocChunk, chunk.File)
+		}
+		if sum, err := hex.DecodeString(chunk.SHA256); err != nil || len(sum) != sha256.Size {
+			return fmt.Errorf(
+				"%w: invalid checksum of file %s", ErrInvalidGenesisAllocChunk, chunk.File,
+			)
+		}
+	}
+	return nil
+}
+
+// GenesisAllocWriter writes the accounts of the genesis alloc to chunk files of newline
+// delimited JSON in a directory, starting a new chunk file every chunk size accounts.
+type GenesisAllocWriter struct {
+	dir       string
+	chunkSize uint64
+	chunks    []GenesisAllocChunk
+
+	// file, buf and sum write the current chunk file, which is nil between chunks.
+	file *os.File
+	buf  *bufio.Writer
+	sum  hash.Hash
+	last *common.Address
+}
+
+// NewGenesisAllocWriter returns a writer of the genesis alloc to chunk files in the directory,
+// which is created if it does not exist.
+func NewGenesisAllocWriter(dir string, chunkSize uint64) (*GenesisAllocWriter, error) {
+	if chunkSize == 0 {
+		return nil, errors.New("genesis alloc chunk size must be positive")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil { //nolint:gomnd // standard permissions.
+		return nil, err
+	}
+	return &GenesisAllocWriter{dir: dir, chunkSize: chunkSize}, nil
+}
+
+// Write writes an account to the current chunk file. Accounts must be written in ascending
+// order of address.
+func (w *GenesisAllocWriter) Write(address common.Address, account core.GenesisAccount) error {
+	if w.last != nil && address.Cmp(*w.last) <= 0 {
+		return fmt.Errorf("genesis alloc account %s is out of order", address.Hex())
+	}
+	w.last = &address
+
+	if w.file == nil {
+		name := fmt.Sprintf("alloc-%06d.ndjson", len(w.chunks))
+		file, err := os.Create(filepath.Join(w.dir, name))
+		if err != nil {
+			return err
+		}
+		w.file, w.sum = file, sha256.New()
+		w.buf = bufio.NewWriter(io.MultiWriter(file, w.sum))
+		w.chunks = append(w.chunks, GenesisAllocChunk{File: name})
+	}
+
+	// The encoder terminates every entry with a newline.
+	if err := json.NewEncoder(w.buf).Encode(&genesisAllocEntry{address, account}); err != nil {
+		return err
+	}
+	chunk := &w.chunks[len(w.chunks)-1]
+	if chunk.Accounts++; chunk.Accounts == w.chunkSize {
+		return w.closeChunk()
+	}
+	return nil
+}
+
+// Chunks returns the chunks that have been completely written.
+func (w *GenesisAllocWriter) Chunks() []GenesisAllocChunk {
+	if w.file != nil {
+		return w.chunks[:len(w.chunks)-1]
+	}
+	return w.chunks
+}
+
+// Close closes the current chunk file and returns the references to all the chunks written.
+func (w *GenesisAllocWriter) Close() ([]GenesisAllocChunk, error) {
+	if w.file != nil {
+		if err := w.closeChunk(); err != nil {
+			return nil, err
+		}
+	}
+	return w.chunks, nil
+}
+
+// closeChunk flushes and closes the current chunk file, and records its checksum.
+func (w *GenesisAllocWriter) closeChunk() error {
+	err := w.buf.Flush()
+	if closeErr := w.file.Close(); err == nil {
+		err = closeErr
+	}
+	w.chunks[len(w.chunks)-1].SHA256 = hex.EncodeToString(w.sum.Sum(nil))
+	w.file, w.buf, w.sum = nil, nil, nil
+	return err
+}
+
+// ReadGenesisAllocChunk verifies the checksum of the chunk file in the directory, then calls fn
+// with each of its accounts in order. It returns an error if the file does not hold the number
+// of accounts of the chunk.
+func ReadGenesisAllocChunk(
+	dir string, chunk GenesisAllocChunk, fn func(common.Address, core.GenesisAccount) error,
+) error {
+	path := filepath.Join(dir, chunk.File)
+
+	// The file is verified in full before any of its accounts are read.
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	sum := sha256.New()
+	if _, err = io.Copy(sum, file); err != nil {
+		return err
+	}
+	if hex.EncodeToString(sum.Sum(nil)) != chunk.SHA256 {
+		return fmt.Errorf("%w: checksum mismatch of file %s", ErrInvalidGenesisAllocChunk, path)
+	}
+	if _, err = file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	var accounts uint64
+	for dec := json.NewDecoder(bufio.NewReader(file)); dec.More(); accounts++ {
+		var entry genesisAllocEntry
+		if err = dec.Decode(&entry); err != nil {
+			return fmt.Errorf("%w: file %s: %w", ErrInvalidGenesisAllocChunk, path, err)
+		}
+		if err = fn(entry.Address, entry.Account); err != nil {
+			return err
+		}
+	}
+	if accounts != chunk.Accounts {
+		return fmt.Errorf(
+			"%w: file %s has %d accounts, expected %d",
+			ErrInvalidGenesisAllocChunk, path, accounts, chunk.Accounts,
+		)
+	}
+	return nil
+}
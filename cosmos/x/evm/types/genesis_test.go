@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"math/big"
+
+	"github.com/berachain/polaris/cosmos/x/evm/types"
+	"github.com/berachain/polaris/eth/core"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/params"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Genesis validation", func() {
+	var (
+		ethGen  *core.Genesis
+		account = common.HexToAddress("0x1234")
+	)
+
+	BeforeEach(func() {
+		ethGen = &core.Genesis{
+			Config:   params.AllDevChainProtocolChanges,
+			GasLimit: 30_000_000,
+			Alloc: core.GenesisAlloc{
+				account: {Balance: big.NewInt(1)},
+			},
+		}
+	})
+
+	It("should accept a valid genesis", func() {
+		Expect(types.ValidateGenesis(ethGen)).To(Succeed())
+		ethGen.Config = nil
+		Expect(types.ValidateGenesis(ethGen)).To(Succeed())
+	})
+
+	It("should reject accounts at precompiles", func() {
+		ethGen.Alloc[common.BytesToAddress([]byte{0x01})] = gethcore.GenesisAccount{}
+		Expect(types.ValidateGenesis(ethGen)).To(MatchError(types.ErrInvalidGenesis))
+
+		delete(ethGen.Alloc, common.BytesToAddress([]byte{0x01}))
+		Expect(types.ValidateGenesis(ethGen, account)).To(MatchError(types.ErrInvalidGenesis))
+	})
+
+	It("should reject storage without code", func() {
+		ethGen.Alloc[account] = gethcore.GenesisAccount{
+			Balance: big.NewInt(1),
+			Storage: map[common.Hash]common.Hash{{}: {0x01}},
+		}
+		Expect(types.ValidateGenesis(ethGen)).To(MatchError(types.ErrInvalidGenesis))
+
+		ethGen.Alloc[account] = gethcore.GenesisAccount{
+			Balance: big.NewInt(1),
+			Code:    []byte{0x00},
+			Storage: map[common.Hash]common.Hash{{}: {0x01}},
+		}
+		Expect(types.ValidateGenesis(ethGen)).To(Succeed())
+	})
+
+	It("should reject invalid chain configs, gas limits and base fees", func() {
+		cfg := *params.AllDevChainProtocolChanges
+		cfg.LondonBlock = nil
+		ethGen.Config = &cfg
+		Expect(types.ValidateGenesis(ethGen)).To(MatchError(types.ErrInvalidGenesis))
+		ethGen.Config = nil
+
+		ethGen.GasLimit = params.MinGasLimit - 1
+		Expect(types.ValidateGenesis(ethGen)).To(MatchError(types.ErrInvalidGenesis))
+		ethGen.GasLimit = 30_000_000
+
+		ethGen.BaseFee = big.NewInt(0)
+		Expect(types.ValidateGenesis(ethGen)).To(MatchError(types.ErrInvalidGenesis))
+	})
+})
+
+var _ = Describe("Genesis alloc chunks", func() {
+	It("should write and read accounts in chunks", func() {
+		dir := GinkgoT().TempDir()
+		w, err := types.NewGenesisAllocWriter(dir, 2)
+		Expect(err).ToNot(HaveOccurred())
+		for i := byte(1); i <= 3; i++ {
+			Expect(w.Write(common.Address{i}, gethcore.GenesisAccount{Balance: big.NewInt(1)})).
+				To(Succeed())
+		}
+		Expect(w.Write(common.Address{0x01}, gethcore.GenesisAccount{})).ToNot(Succeed())
+		chunks, err := w.Close()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(chunks).To(HaveLen(2))
+		Expect(types.ValidateGenesisAllocChunks(chunks)).To(Succeed())
+
+		var read []common.Address
+		for _, chunk := range chunks {
+			Expect(types.ReadGenesisAllocChunk(dir, chunk,
+				func(addr common.Address, account gethcore.GenesisAccount) error {
+					Expect(account.Balance).To(Equal(big.NewInt(1)))
+					read = append(read, addr)
+					return nil
+				},
+			)).To(Succeed())
+		}
+		Expect(read).To(Equal([]common.Address{{0x01}, {0x02}, {0x03}}))
+
+		chunks[1].Accounts = 2
+		Expect(types.ReadGenesisAllocChunk(dir, chunks[1],
+			func(common.Address, gethcore.GenesisAccount) error { return nil },
+		)).To(MatchError(types.ErrInvalidGenesisAllocChunk))
+	})
+
+	It("should reject malformed chunk references", func() {
+		sum := "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+		for _, chunk := range []types.GenesisAllocChunk{
+			{File: "", Accounts: 1, SHA256: sum},
+			{File: "../alloc.ndjson", Accounts: 1, SHA256: sum},
+			{File: "alloc.ndjson", Accounts: 0, SHA256: sum},
+			{File: "alloc.ndjson", Accounts: 1, SHA256: "00"},
+		} {
+			Expect(types.ValidateGenesisAllocChunks([]types.GenesisAllocChunk{chunk})).
+				To(MatchError(types.ErrInvalidGenesisAllocChunk))
+		}
+	})
+})
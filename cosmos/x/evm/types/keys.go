@@ -41,4 +41,6 @@ const (
 	GenesisHeaderKey
 	ParamsKey
 	ChainConfigPrefix
+	BlockNumKeyToPrunedBlockPrefix
+	HistoryTailKey
 )
@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// DefaultAllowedValidatorMsgs are the Cosmos messages that validators may include in proposals by
+// default.
+var DefaultAllowedValidatorMsgs = []string{
+	// crisis
+	"cosmos.crisis.v1beta1.MsgVerifyInvariant",
+
+	// evidence
+	"cosmos.evidence.v1beta1.MsgSubmitEvidence",
+
+	// gov
+	"cosmos.gov.v1.MsgDeposit",
+	"cosmos.gov.v1.MsgVote",
+	"cosmos.gov.v1.MsgVoteWeighted",
+	"cosmos.gov.v1beta1.MsgDeposit",
+	"cosmos.gov.v1beta1.MsgVote",
+	"cosmos.gov.v1beta1.MsgVoteWeighted",
+
+	// slashing
+	"cosmos.slashing.v1beta1.MsgUnjail",
+
+	// staking
+	"cosmos.staking.v1beta1.MsgCreateValidator",
+	"cosmos.staking.v1beta1.MsgEditValidator",
+}
+
+const (
+	// DefaultCosmosGasReserveBps is the share of the block gas reserved for Cosmos transactions
+	// by default.
+	DefaultCosmosGasReserveBps = 1000
+
+	// DefaultCosmosBytesReserveBps is the share of the block bytes reserved for Cosmos
+	// transactions by default.
+	DefaultCosmosBytesReserveBps = 1000
+
+	// DefaultEVMGasRatioBps charges one unit of Cosmos gas per unit of evm gas by default.
+	DefaultEVMGasRatioBps = BasisPoints
+
+	// DefaultEnvelopeEncoding is the encoding of the payload envelopes of new chains.
+	DefaultEnvelopeEncoding = EnvelopeEncoding_ENVELOPE_ENCODING_RLP_SNAPPY
+)
+
+// DefaultParams returns the default parameters of the x/evm module.
+func DefaultParams() Params {
+	allowed := make([]string, len(DefaultAllowedValidatorMsgs))
+	copy(allowed, DefaultAllowedValidatorMsgs)
+	return Params{
+		AllowedValidatorMsgs:  allowed,
+		CosmosGasReserveBps:   DefaultCosmosGasReserveBps,
+		CosmosBytesReserveBps: DefaultCosmosBytesReserveBps,
+		EvmGasRatioBps:        DefaultEVMGasRatioBps,
+		EnvelopeEncoding:      DefaultEnvelopeEncoding,
+	}
+}
+
+// Validate checks that the allowed validator messages are unique, well formed message names
+// that do not include the messages of the x/evm module itself, which are placed by the miner,
+// that the block space reserves are valid shares, that evm gas is charged to the block and that
+// the envelope encoding is known.
+func (p Params) Validate() error {
+	if p.EvmGasRatioBps == 0 {
+		return errors.New("evm gas ratio must be positive")
+	}
+	if _, ok := EnvelopeEncoding_name[int32(p.EnvelopeEncoding)]; !ok {
+		return fmt.Errorf("unknown envelope encoding %d", p.EnvelopeEncoding)
+	}
+	if p.CosmosGasReserveBps > BasisPoints {
+		return fmt.Errorf("cosmos gas reserve %d exceeds %d bps", p.CosmosGasReserveBps, BasisPoints)
+	}
+	if p.CosmosBytesReserveBps > BasisPoints {
+		return fmt.Errorf(
+			"cosmos bytes reserve %d exceeds %d bps", p.CosmosBytesReserveBps, BasisPoints,
+		)
+	}
+
+	seen := make(map[string]struct{}, len(p.AllowedValidatorMsgs))
+	for _, name := range p.AllowedValidatorMsgs {
+		if name == "" {
+			return errors.New("allowed validator msg name cannot be empty")
+		}
+		if name[0] == '/' {
+			return fmt.Errorf("allowed validator msg %s must be a message name, not a type url", name)
+		}
+		if _, ok := seen[name]; ok {
+			return fmt.Errorf("duplicate allowed validator msg %s", name)
+		}
+		if name == proto.MessageName(&WrappedPayloadEnvelope{}) ||
+			name == proto.MessageName(&WrappedEthereumTransaction{}) {
+			return fmt.Errorf("evm msg %s cannot be allowed for validators", name)
+		}
+		seen[name] = struct{}{}
+	}
+	return nil
+}
+
+// EVMGasToCosmos converts the given evm gas into the Cosmos gas charged for it to the block gas
+// meter, saturating at the maximum gas.
+func (p Params) EVMGasToCosmos(gas uint64) uint64 {
+	hi, lo := bits.Mul64(gas, uint64(p.EvmGasRatioBps))
+	if hi >= BasisPoints {
+		return math.MaxUint64
+	}
+	quo, _ := bits.Div64(hi, lo, BasisPoints)
+	return quo
+}
+
+// CosmosGasToEVM converts the given Cosmos gas into the evm gas that is charged at most that much,
+// saturating at the maximum gas.
+func (p Params) CosmosGasToEVM(gas uint64) uint64 {
+	hi, lo := bits.Mul64(gas, BasisPoints)
+	if hi >= uint64(p.EvmGasRatioBps) {
+		return math.MaxUint64
+	}
+	quo, _ := bits.Div64(hi, lo, uint64(p.EvmGasRatioBps))
+	return quo
+}
+
+// IsAllowedValidatorMsg returns whether the message with the given full name may be included in
+// proposals by validators.
+func (p Params) IsAllowedValidatorMsg(name string) bool {
+	for _, allowed := range p.AllowedValidatorMsgs {
+		if allowed == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,522 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: polaris/evm/v1alpha1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EnvelopeEncoding defines the encodings of the payload envelope in a WrappedPayloadEnvelope.
+type EnvelopeEncoding int32
+
+const (
+	// ENVELOPE_ENCODING_JSON is the legacy JSON encoding of the engine API.
+	EnvelopeEncoding_ENVELOPE_ENCODING_JSON EnvelopeEncoding = 0
+	// ENVELOPE_ENCODING_RLP is the RLP encoding of the envelope.
+	EnvelopeEncoding_ENVELOPE_ENCODING_RLP EnvelopeEncoding = 1
+	// ENVELOPE_ENCODING_RLP_SNAPPY is the snappy compressed RLP encoding of the envelope.
+	EnvelopeEncoding_ENVELOPE_ENCODING_RLP_SNAPPY EnvelopeEncoding = 2
+	// ENVELOPE_ENCODING_RLP_ZSTD is the zstd compressed RLP encoding of the envelope.
+	EnvelopeEncoding_ENVELOPE_ENCODING_RLP_ZSTD EnvelopeEncoding = 3
+)
+
+var EnvelopeEncoding_name = map[int32]string{
+	0: "ENVELOPE_ENCODING_JSON",
+	1: "ENVELOPE_ENCODING_RLP",
+	2: "ENVELOPE_ENCODING_RLP_SNAPPY",
+	3: "ENVELOPE_ENCODING_RLP_ZSTD",
+}
+
+var EnvelopeEncoding_value = map[string]int32{
+	"ENVELOPE_ENCODING_JSON":       0,
+	"ENVELOPE_ENCODING_RLP":        1,
+	"ENVELOPE_ENCODING_RLP_SNAPPY": 2,
+	"ENVELOPE_ENCODING_RLP_ZSTD":   3,
+}
+
+func (x EnvelopeEncoding) String() string {
+	return proto.EnumName(EnvelopeEncoding_name, int32(x))
+}
+
+func (EnvelopeEncoding) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9f6c2eac5100e18c, []int{0}
+}
+
+// Params defines the parameters of the x/evm module.
+type Params struct {
+	// allowed_validator_msgs are the full names of the Cosmos messages that validators may
+	// include in proposals alongside the payload envelope.
+	AllowedValidatorMsgs []string `protobuf:"bytes,1,rep,name=allowed_validator_msgs,json=allowedValidatorMsgs,proto3" json:"allowed_validator_msgs,omitempty"`
+	// cosmos_gas_reserve_bps is the share of the block gas limit, in basis points, that is
+	// reserved for Cosmos transactions and may not be used by the payload envelope.
+	CosmosGasReserveBps uint32 `protobuf:"varint,2,opt,name=cosmos_gas_reserve_bps,json=cosmosGasReserveBps,proto3" json:"cosmos_gas_reserve_bps,omitempty"`
+	// cosmos_bytes_reserve_bps is the share of the block byte limit, in basis points, that is
+	// reserved for Cosmos transactions and may not be used by the payload envelope.
+	CosmosBytesReserveBps uint32 `protobuf:"varint,3,opt,name=cosmos_bytes_reserve_bps,json=cosmosBytesReserveBps,proto3" json:"cosmos_bytes_reserve_bps,omitempty"`
+	// evm_gas_ratio_bps is the Cosmos gas that is charged to the block gas meter per unit of gas
+	// used by the payload envelope, in basis points.
+	EvmGasRatioBps uint32 `protobuf:"varint,4,opt,name=evm_gas_ratio_bps,json=evmGasRatioBps,proto3" json:"evm_gas_ratio_bps,omitempty"`
+	// envelope_encoding is the encoding of the payload envelopes that proposers place in blocks.
+	// Envelopes of every encoding are accepted regardless, so that it can be changed at an
+	// upgrade height.
+	EnvelopeEncoding EnvelopeEncoding `protobuf:"varint,5,opt,name=envelope_encoding,json=envelopeEncoding,proto3,enum=polaris.evm.v1alpha1.EnvelopeEncoding" json:"envelope_encoding,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f6c2eac5100e18c, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetAllowedValidatorMsgs() []string {
+	if m != nil {
+		return m.AllowedValidatorMsgs
+	}
+	return nil
+}
+
+func (m *Params) GetCosmosGasReserveBps() uint32 {
+	if m != nil {
+		return m.CosmosGasReserveBps
+	}
+	return 0
+}
+
+func (m *Params) GetCosmosBytesReserveBps() uint32 {
+	if m != nil {
+		return m.CosmosBytesReserveBps
+	}
+	return 0
+}
+
+func (m *Params) GetEvmGasRatioBps() uint32 {
+	if m != nil {
+		return m.EvmGasRatioBps
+	}
+	return 0
+}
+
+func (m *Params) GetEnvelopeEncoding() EnvelopeEncoding {
+	if m != nil {
+		return m.EnvelopeEncoding
+	}
+	return EnvelopeEncoding_ENVELOPE_ENCODING_JSON
+}
+
+func init() {
+	proto.RegisterEnum("polaris.evm.v1alpha1.EnvelopeEncoding", EnvelopeEncoding_name, EnvelopeEncoding_value)
+	proto.RegisterType((*Params)(nil), "polaris.evm.v1alpha1.Params")
+}
+
+func init() { proto.RegisterFile("polaris/evm/v1alpha1/params.proto", fileDescriptor_9f6c2eac5100e18c) }
+
+var fileDescriptor_9f6c2eac5100e18c = []byte{
+	// 386 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x4f, 0x8b, 0xd3, 0x40,
+	0x14, 0xc0, 0x33, 0xad, 0x2e, 0x38, 0xe0, 0x92, 0x1d, 0x77, 0x4b, 0x5c, 0x24, 0x44, 0x0f, 0x52,
+	0x3d, 0x24, 0xac, 0x2b, 0x78, 0xb6, 0x6e, 0x58, 0x56, 0x6a, 0x1a, 0x12, 0x29, 0xd8, 0xcb, 0x30,
+	0x49, 0x1f, 0x69, 0x20, 0x93, 0x19, 0x32, 0x71, 0xb4, 0x9f, 0xc1, 0x8b, 0x1f, 0xc4, 0x0f, 0xe2,
+	0xb1, 0x47, 0x8f, 0xd2, 0x7e, 0x11, 0xc9, 0x9f, 0x82, 0xd6, 0x5e, 0xdf, 0xef, 0xf7, 0xe3, 0xc1,
+	0xe3, 0xe1, 0xa7, 0x52, 0x14, 0xac, 0xca, 0x95, 0x07, 0x9a, 0x7b, 0xfa, 0x8a, 0x15, 0x72, 0xc5,
+	0xae, 0x3c, 0xc9, 0x2a, 0xc6, 0x95, 0x2b, 0x2b, 0x51, 0x0b, 0x72, 0xde, 0x2b, 0x2e, 0x68, 0xee,
+	0xee, 0x95, 0x67, 0x3f, 0x06, 0xf8, 0x24, 0x6c, 0x35, 0xf2, 0x1a, 0x8f, 0x58, 0x51, 0x88, 0x2f,
+	0xb0, 0xa4, 0x9a, 0x15, 0xf9, 0x92, 0xd5, 0xa2, 0xa2, 0x5c, 0x65, 0xca, 0x42, 0xce, 0x70, 0xfc,
+	0x20, 0x3a, 0xef, 0xe9, 0x7c, 0x0f, 0x3f, 0xa8, 0x4c, 0x91, 0x6b, 0x3c, 0x4a, 0x85, 0xe2, 0x42,
+	0xd1, 0x8c, 0x29, 0x5a, 0x81, 0x82, 0x4a, 0x03, 0x4d, 0xa4, 0xb2, 0x06, 0x0e, 0x1a, 0x3f, 0x8c,
+	0x1e, 0x75, 0xf4, 0x96, 0xa9, 0xa8, 0x63, 0x13, 0xa9, 0xc8, 0x1b, 0x6c, 0xf5, 0x51, 0xb2, 0xae,
+	0xe1, 0xdf, 0x6c, 0xd8, 0x66, 0x17, 0x1d, 0x9f, 0x34, 0xf8, 0xaf, 0xf0, 0x05, 0x3e, 0x03, 0xcd,
+	0xbb, 0x55, 0xac, 0xce, 0x45, 0x5b, 0xdc, 0x6b, 0x8b, 0x53, 0xd0, 0xbc, 0xd9, 0xd2, 0x8c, 0x1b,
+	0x35, 0xc6, 0x67, 0x50, 0x6a, 0x28, 0x84, 0x04, 0x0a, 0x65, 0x2a, 0x96, 0x79, 0x99, 0x59, 0xf7,
+	0x1d, 0x34, 0x3e, 0x7d, 0xf5, 0xdc, 0x3d, 0x76, 0x0b, 0xd7, 0xef, 0x75, 0xbf, 0xb7, 0x23, 0x13,
+	0x0e, 0x26, 0x2f, 0xbf, 0x21, 0x6c, 0x1e, 0x6a, 0xe4, 0x12, 0x8f, 0xfc, 0x60, 0xee, 0x4f, 0x67,
+	0xa1, 0x4f, 0xfd, 0xe0, 0xdd, 0xec, 0xe6, 0x2e, 0xb8, 0xa5, 0xef, 0xe3, 0x59, 0x60, 0x1a, 0xe4,
+	0x31, 0xbe, 0xf8, 0x9f, 0x45, 0xd3, 0xd0, 0x44, 0xc4, 0xc1, 0x4f, 0x8e, 0x22, 0x1a, 0x07, 0x6f,
+	0xc3, 0xf0, 0x93, 0x39, 0x20, 0x36, 0xbe, 0x3c, 0x6e, 0x2c, 0xe2, 0x8f, 0x37, 0xe6, 0x70, 0x72,
+	0xf7, 0x73, 0x6b, 0xa3, 0xcd, 0xd6, 0x46, 0xbf, 0xb7, 0x36, 0xfa, 0xbe, 0xb3, 0x8d, 0xcd, 0xce,
+	0x36, 0x7e, 0xed, 0x6c, 0x63, 0xe1, 0x65, 0x79, 0xbd, 0xfa, 0x9c, 0xb8, 0xa9, 0xe0, 0x5e, 0x02,
+	0x15, 0x4b, 0x57, 0x2c, 0x2f, 0xbd, 0xfd, 0x93, 0x74, 0xb7, 0xf5, 0xbe, 0xb6, 0xdf, 0x52, 0xaf,
+	0x25, 0xa8, 0xe4, 0xa4, 0x7d, 0x92, 0xeb, 0x3f, 0x03, 0x00, 0x2b, 0x4e, 0x31, 0xe5, 0x49, 0x02,
+	0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EnvelopeEncoding != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EnvelopeEncoding))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EvmGasRatioBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EvmGasRatioBps))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CosmosBytesReserveBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CosmosBytesReserveBps))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CosmosGasReserveBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CosmosGasReserveBps))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AllowedValidatorMsgs) > 0 {
+		for iNdEx := len(m.AllowedValidatorMsgs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedValidatorMsgs[iNdEx])
+			copy(dAtA[i:], m.AllowedValidatorMsgs[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedValidatorMsgs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedValidatorMsgs) > 0 {
+		for _, s := range m.AllowedValidatorMsgs {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.CosmosGasReserveBps != 0 {
+		n += 1 + sovParams(uint64(m.CosmosGasReserveBps))
+	}
+	if m.CosmosBytesReserveBps != 0 {
+		n += 1 + sovParams(uint64(m.CosmosBytesReserveBps))
+	}
+	if m.EvmGasRatioBps != 0 {
+		n += 1 + sovParams(uint64(m.EvmGasRatioBps))
+	}
+	if m.EnvelopeEncoding != 0 {
+		n += 1 + sovParams(uint64(m.EnvelopeEncoding))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedValidatorMsgs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedValidatorMsgs = append(m.AllowedValidatorMsgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosGasReserveBps", wireType)
+			}
+			m.CosmosGasReserveBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosGasReserveBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosBytesReserveBps", wireType)
+			}
+			m.CosmosBytesReserveBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosBytesReserveBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmGasRatioBps", wireType)
+			}
+			m.EvmGasRatioBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvmGasRatioBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnvelopeEncoding", wireType)
+			}
+			m.EnvelopeEncoding = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EnvelopeEncoding |= EnvelopeEncoding(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// ErrInvalidBlobsBundle is returned when the blobs bundle of an envelope does not match the
+// commitments of its blob transactions.
+var ErrInvalidBlobsBundle = errors.New("invalid blobs bundle")
+
+// ParentBeaconRoot returns the EIP-4788 parent beacon block root of the block executed at the
+// given context, which is the app hash of the last committed CometBFT block. It is identical
+// during PrepareProposal, ProcessProposal and FinalizeBlock of a height.
+func ParentBeaconRoot(ctx sdk.Context) common.Hash {
+	return common.BytesToHash(ctx.BlockHeader().AppHash)
+}
+
+// EnvelopeToBlock converts an execution payload envelope into a block. The blob sidecars that
+// travel with the envelope are verified against their KZG commitments, which are in turn used to
+// check the blob versioned hashes of the transactions in the payload. As the parent beacon root
+// is part of the block hash, a payload built on a different root fails to convert.
+func EnvelopeToBlock(
+	chainConfig *params.ChainConfig, envelope *engine.ExecutionPayloadEnvelope,
+	parentBeaconRoot common.Hash,
+) (*ethtypes.Block, error) {
+	payload := envelope.ExecutionPayload
+	if payload == nil {
+		return nil, errors.New("envelope is missing execution payload")
+	}
+
+	var (
+		versionedHashes []common.Hash
+		beaconRoot      *common.Hash
+	)
+	if chainConfig.IsCancun(new(big.Int).SetUint64(payload.Number), payload.Timestamp) {
+		beaconRoot = &parentBeaconRoot
+
+		var err error
+		if versionedHashes, err = verifyBlobsBundle(envelope.BlobsBundle); err != nil {
+			return nil, err
+		}
+	}
+
+	return engine.ExecutableDataToBlock(*payload, versionedHashes, beaconRoot)
+}
+
+// verifyBlobsBundle checks the blob proofs of a blobs bundle and returns the versioned hashes
+// of its commitments.
+func verifyBlobsBundle(bundle *engine.BlobsBundleV1) ([]common.Hash, error) {
+	if bundle == nil {
+		return nil, nil
+	}
+	if len(bundle.Blobs) != len(bundle.Commitments) || len(bundle.Blobs) != len(bundle.Proofs) {
+		return nil, fmt.Errorf(
+			"%w: %d blobs, %d commitments, %d proofs", ErrInvalidBlobsBundle,
+			len(bundle.Blobs), len(bundle.Commitments), len(bundle.Proofs),
+		)
+	}
+
+	var (
+		hasher          = sha256.New()
+		versionedHashes = make([]common.Hash, len(bundle.Commitments))
+	)
+	for i := range bundle.Blobs {
+		var (
+			blob       kzg4844.Blob
+			commitment kzg4844.Commitment
+			proof      kzg4844.Proof
+		)
+		if len(bundle.Blobs[i]) != len(blob) ||
+			len(bundle.Commitments[i]) != len(commitment) ||
+			len(bundle.Proofs[i]) != len(proof) {
+			return nil, fmt.Errorf("%w: malformed sidecar at index %d", ErrInvalidBlobsBundle, i)
+		}
+		copy(blob[:], bundle.Blobs[i])
+		copy(commitment[:], bundle.Commitments[i])
+		copy(proof[:], bundle.Proofs[i])
+
+		if err := kzg4844.VerifyBlobProof(blob, commitment, proof); err != nil {
+			return nil, fmt.Errorf("%w: blob %d: %w", ErrInvalidBlobsBundle, i, err)
+		}
+
+		hasher.Reset()
+		hasher.Write(commitment[:])
+		hasher.Sum(versionedHashes[i][:0])
+		versionedHashes[i][0] = params.BlobTxHashVersion
+	}
+	return versionedHashes, nil
+}
@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"math/big"
+
+	"github.com/berachain/polaris/cosmos/x/evm/types"
+	"github.com/berachain/polaris/eth/params"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/trie"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// newSidecar returns the sidecar of a blob with the given first byte.
+func newSidecar(b byte) *ethtypes.BlobTxSidecar {
+	blob := kzg4844.Blob{b}
+	commitment, err := kzg4844.BlobToCommitment(blob)
+	Expect(err).ToNot(HaveOccurred())
+	proof, err := kzg4844.ComputeBlobProof(blob, commitment)
+	Expect(err).ToNot(HaveOccurred())
+	return &ethtypes.BlobTxSidecar{
+		Blobs:       []kzg4844.Blob{blob},
+		Commitments: []kzg4844.Commitment{commitment},
+		Proofs:      []kzg4844.Proof{proof},
+	}
+}
+
+// newEnvelope returns the envelope of a Cancun payload with the given transactions and blob
+// sidecars, built on the given parent beacon root.
+func newEnvelope(
+	beaconRoot common.Hash, txs ethtypes.Transactions, sidecars []*ethtypes.BlobTxSidecar,
+) *engine.ExecutionPayloadEnvelope {
+	blobGasUsed, excessBlobGas := uint64(0), uint64(0)
+	block := ethtypes.NewBlockWithWithdrawals(&ethtypes.Header{
+		ParentHash:       common.Hash{0x01},
+		Difficulty:       common.Big0,
+		Number:           big.NewInt(7),
+		GasLimit:         30_000_000,
+		Time:             1700000000,
+		BaseFee:          big.NewInt(1_000_000_000),
+		BlobGasUsed:      &blobGasUsed,
+		ExcessBlobGas:    &excessBlobGas,
+		ParentBeaconRoot: &beaconRoot,
+	}, txs, nil, nil, ethtypes.Withdrawals{}, trie.NewStackTrie(nil))
+	return engine.BlockToExecutableData(block, big.NewInt(0), sidecars)
+}
+
+var _ = Describe("EnvelopeToBlock", func() {
+	beaconRoot := common.Hash{0x02}
+
+	It("should convert a payload built on the parent beacon root", func() {
+		envelope := newEnvelope(beaconRoot, nil, nil)
+		block, err := types.EnvelopeToBlock(params.DefaultChainConfig, envelope, beaconRoot)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(block.Hash()).To(Equal(envelope.ExecutionPayload.BlockHash))
+		Expect(*block.BeaconRoot()).To(Equal(beaconRoot))
+	})
+
+	It("should reject a payload built on another parent beacon root", func() {
+		envelope := newEnvelope(common.Hash{0x03}, nil, nil)
+		_, err := types.EnvelopeToBlock(params.DefaultChainConfig, envelope, beaconRoot)
+		Expect(err).To(MatchError(ContainSubstring("blockhash mismatch")))
+	})
+
+	Describe("with blobs", func() {
+		var (
+			sidecar  *ethtypes.BlobTxSidecar
+			envelope *engine.ExecutionPayloadEnvelope
+		)
+
+		BeforeEach(func() {
+			sidecar = newSidecar(0x01)
+			envelope = newEnvelope(beaconRoot, ethtypes.Transactions{
+				ethtypes.NewTx(&ethtypes.BlobTx{BlobHashes: sidecar.BlobHashes()}),
+			}, []*ethtypes.BlobTxSidecar{sidecar})
+		})
+
+		It("should convert a payload with a valid blobs bundle", func() {
+			block, err := types.EnvelopeToBlock(params.DefaultChainConfig, envelope, beaconRoot)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(block.Transactions()[0].BlobHashes()).To(Equal(sidecar.BlobHashes()))
+		})
+
+		DescribeTable("should reject an invalid blobs bundle",
+			func(modify func(*engine.BlobsBundleV1)) {
+				modify(envelope.BlobsBundle)
+				_, err := types.EnvelopeToBlock(params.DefaultChainConfig, envelope, beaconRoot)
+				Expect(err).To(MatchError(types.ErrInvalidBlobsBundle))
+			},
+			Entry("missing commitment", func(bundle *engine.BlobsBundleV1) {
+				bundle.Commitments = nil
+			}),
+			Entry("missing proof", func(bundle *engine.BlobsBundleV1) {
+				bundle.Proofs = nil
+			}),
+			Entry("extra blob", func(bundle *engine.BlobsBundleV1) {
+				bundle.Blobs = append(bundle.Blobs, bundle.Blobs[0])
+			}),
+			Entry("malformed commitment", func(bundle *engine.BlobsBundleV1) {
+				bundle.Commitments[0] = bundle.Commitments[0][1:]
+			}),
+			Entry("commitment of another blob", func(bundle *engine.BlobsBundleV1) {
+				bundle.Commitments[0] = hexutil.Bytes(newSidecar(0x02).Commitments[0][:])
+			}),
+			Entry("proof of another blob", func(bundle *engine.BlobsBundleV1) {
+				bundle.Proofs[0] = hexutil.Bytes(newSidecar(0x02).Proofs[0][:])
+			}),
+		)
+
+		It("should reject blobs that the transactions do not commit to", func() {
+			other := newSidecar(0x02)
+			envelope.BlobsBundle = &engine.BlobsBundleV1{
+				Blobs:       []hexutil.Bytes{other.Blobs[0][:]},
+				Commitments: []hexutil.Bytes{other.Commitments[0][:]},
+				Proofs:      []hexutil.Bytes{other.Proofs[0][:]},
+			}
+			_, err := types.EnvelopeToBlock(params.DefaultChainConfig, envelope, beaconRoot)
+			Expect(err).To(MatchError(ContainSubstring("invalid versionedHash")))
+		})
+	})
+})
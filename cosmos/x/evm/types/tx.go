@@ -21,12 +21,19 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/ethereum/go-ethereum/beacon/engine"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
+// ErrEnvelopeNotAllowed is returned when a payload envelope is submitted outside of a proposal,
+// as only the block proposer may place it in a block.
+var ErrEnvelopeNotAllowed = errors.New("payload envelopes can only be placed by the proposer")
+
 // WrapTx sets the transaction data from an `coretypes.Transaction`.
 func WrapTx(tx *ethtypes.Transaction) (*WrappedEthereumTransaction, error) {
 	bz, err := tx.MarshalBinary()
@@ -48,9 +55,12 @@ func (etr *WrappedEthereumTransaction) Unwrap() *ethtypes.Transaction {
 	return tx
 }
 
-// WrapPayload sets the payload data from an `engine.ExecutionPayloadEnvelope`.
-func WrapPayload(envelope *engine.ExecutionPayloadEnvelope) (*WrappedPayloadEnvelope, error) {
-	bz, err := envelope.MarshalJSON()
+// WrapPayload sets the payload data from an `engine.ExecutionPayloadEnvelope` in the given
+// encoding.
+func WrapPayload(
+	envelope *engine.ExecutionPayloadEnvelope, encoding EnvelopeEncoding,
+) (*WrappedPayloadEnvelope, error) {
+	bz, err := EncodeEnvelope(envelope, encoding)
 	if err != nil {
 		return nil, fmt.Errorf("failed to wrap payload: %w", err)
 	}
@@ -60,11 +70,18 @@ func WrapPayload(envelope *engine.ExecutionPayloadEnvelope) (*WrappedPayloadEnve
 	}, nil
 }
 
-// AsPayload extracts the payload as an `engine.ExecutionPayloadEnvelope`.
-func (wpe *WrappedPayloadEnvelope) UnwrapPayload() *engine.ExecutionPayloadEnvelope {
-	payload := new(engine.ExecutionPayloadEnvelope)
-	if err := payload.UnmarshalJSON(wpe.Data); err != nil {
-		return nil
+// UnwrapPayload extracts the payload as an `engine.ExecutionPayloadEnvelope`, whatever its
+// encoding.
+func (wpe *WrappedPayloadEnvelope) UnwrapPayload() (*engine.ExecutionPayloadEnvelope, error) {
+	return DecodeEnvelope(wpe.Data)
+}
+
+// ContainsPayloadEnvelope returns whether any of the given messages is a payload envelope.
+func ContainsPayloadEnvelope(msgs []sdk.Msg) bool {
+	for _, msg := range msgs {
+		if _, ok := msg.(*WrappedPayloadEnvelope); ok {
+			return true
+		}
 	}
-	return payload
+	return false
 }
@@ -6,7 +6,9 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	grpc "google.golang.org/grpc"
@@ -236,44 +238,245 @@ func (m *WrappedEthereumTransactionResult) GetStatus() Status {
 	return Status_STATUS_REVERT_UNSPECIFIED
 }
 
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	// authority is the address that controls the module, the governance module by default.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params are the x/evm parameters to update, all fields must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8b33d2a2c64400f, []int{4}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response of the Msg/UpdateParams method.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8b33d2a2c64400f, []int{5}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgUpdateChainConfig is the Msg/UpdateChainConfig request type.
+type MsgUpdateChainConfig struct {
+	// authority is the address that controls the module, the governance module by default.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// chain_config is the JSON encoded Ethereum chain config to update to, all fields must be
+	// supplied. Only the forks that are not active yet may change.
+	ChainConfig string `protobuf:"bytes,2,opt,name=chain_config,json=chainConfig,proto3" json:"chain_config,omitempty"`
+}
+
+func (m *MsgUpdateChainConfig) Reset()         { *m = MsgUpdateChainConfig{} }
+func (m *MsgUpdateChainConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateChainConfig) ProtoMessage()    {}
+func (*MsgUpdateChainConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8b33d2a2c64400f, []int{6}
+}
+func (m *MsgUpdateChainConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateChainConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateChainConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateChainConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateChainConfig.Merge(m, src)
+}
+func (m *MsgUpdateChainConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateChainConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateChainConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateChainConfig proto.InternalMessageInfo
+
+func (m *MsgUpdateChainConfig) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateChainConfig) GetChainConfig() string {
+	if m != nil {
+		return m.ChainConfig
+	}
+	return ""
+}
+
+// MsgUpdateChainConfigResponse defines the response of the Msg/UpdateChainConfig method.
+type MsgUpdateChainConfigResponse struct {
+}
+
+func (m *MsgUpdateChainConfigResponse) Reset()         { *m = MsgUpdateChainConfigResponse{} }
+func (m *MsgUpdateChainConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateChainConfigResponse) ProtoMessage()    {}
+func (*MsgUpdateChainConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8b33d2a2c64400f, []int{7}
+}
+func (m *MsgUpdateChainConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateChainConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateChainConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateChainConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateChainConfigResponse.Merge(m, src)
+}
+func (m *MsgUpdateChainConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateChainConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateChainConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateChainConfigResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("polaris.evm.v1alpha1.Status", Status_name, Status_value)
 	proto.RegisterType((*WrappedEthereumTransaction)(nil), "polaris.evm.v1alpha1.WrappedEthereumTransaction")
 	proto.RegisterType((*WrappedPayloadEnvelope)(nil), "polaris.evm.v1alpha1.WrappedPayloadEnvelope")
 	proto.RegisterType((*WrappedPayloadEnvelopeResponse)(nil), "polaris.evm.v1alpha1.WrappedPayloadEnvelopeResponse")
 	proto.RegisterType((*WrappedEthereumTransactionResult)(nil), "polaris.evm.v1alpha1.WrappedEthereumTransactionResult")
+	proto.RegisterType((*MsgUpdateParams)(nil), "polaris.evm.v1alpha1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "polaris.evm.v1alpha1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgUpdateChainConfig)(nil), "polaris.evm.v1alpha1.MsgUpdateChainConfig")
+	proto.RegisterType((*MsgUpdateChainConfigResponse)(nil), "polaris.evm.v1alpha1.MsgUpdateChainConfigResponse")
 }
 
 func init() { proto.RegisterFile("polaris/evm/v1alpha1/tx.proto", fileDescriptor_d8b33d2a2c64400f) }
 
 var fileDescriptor_d8b33d2a2c64400f = []byte{
-	// 407 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0xaa, 0xd3, 0x40,
-	0x14, 0xc6, 0x93, 0x8b, 0x76, 0x31, 0x48, 0x29, 0xa3, 0xdc, 0xab, 0xc1, 0x1b, 0x4a, 0x57, 0x72,
-	0xb9, 0x64, 0xda, 0x5a, 0xdc, 0x6b, 0x3a, 0x42, 0x40, 0x6b, 0xc9, 0x24, 0x2a, 0x6e, 0xca, 0x34,
-	0x1d, 0x9a, 0x40, 0x92, 0x19, 0x66, 0x26, 0xa1, 0x15, 0x17, 0xbe, 0x80, 0xe0, 0xa3, 0xf8, 0x18,
-	0x2e, 0xbb, 0x74, 0x29, 0xed, 0xc2, 0xd7, 0x10, 0xd3, 0x14, 0xa4, 0xa4, 0x17, 0xba, 0x9a, 0xe1,
-	0x7c, 0xbf, 0x73, 0x38, 0x7f, 0x3e, 0x70, 0x2d, 0x78, 0x4a, 0x65, 0xa2, 0x10, 0x2b, 0x33, 0x54,
-	0x0e, 0x68, 0x2a, 0x62, 0x3a, 0x40, 0x7a, 0xe5, 0x08, 0xc9, 0x35, 0x87, 0x8f, 0x6a, 0xd9, 0x61,
-	0x65, 0xe6, 0x1c, 0x64, 0xeb, 0x2a, 0xe2, 0x2a, 0xe3, 0x0a, 0x65, 0x6a, 0x89, 0xca, 0xc1, 0xbf,
-	0x67, 0x8f, 0xf7, 0xfa, 0xc0, 0xfa, 0x20, 0xa9, 0x10, 0x6c, 0x81, 0x75, 0xcc, 0x24, 0x2b, 0xb2,
-	0x40, 0xd2, 0x5c, 0xd1, 0x48, 0x27, 0x3c, 0x87, 0x10, 0xdc, 0x5b, 0x50, 0x4d, 0x1f, 0x9b, 0x5d,
-	0xf3, 0xd9, 0x03, 0xbf, 0xfa, 0xf7, 0x6e, 0xc1, 0x65, 0x9d, 0x31, 0xa5, 0xeb, 0x94, 0xd3, 0x05,
-	0xce, 0x4b, 0x96, 0x72, 0xc1, 0x1a, 0xe9, 0x2e, 0xb0, 0x9b, 0x69, 0x9f, 0x29, 0xc1, 0x73, 0xc5,
-	0x7a, 0x1f, 0x41, 0xf7, 0x74, 0x07, 0x3e, 0x53, 0x45, 0xaa, 0xe1, 0x08, 0xb4, 0x94, 0xa6, 0xba,
-	0x50, 0x55, 0xed, 0xf6, 0xf0, 0xa9, 0xd3, 0x34, 0xa5, 0x43, 0x2a, 0xc6, 0xaf, 0xd9, 0x9b, 0x00,
-	0xb4, 0xf6, 0x11, 0x78, 0x0d, 0x9e, 0x90, 0xe0, 0x65, 0x10, 0x92, 0x99, 0x8f, 0xdf, 0x63, 0x3f,
-	0x98, 0x85, 0x13, 0x32, 0xc5, 0xae, 0xf7, 0xda, 0xc3, 0xe3, 0x8e, 0x01, 0x21, 0x68, 0xd7, 0x32,
-	0x09, 0x5d, 0x17, 0x13, 0xd2, 0x31, 0xe1, 0x15, 0x78, 0x58, 0xc7, 0x26, 0xef, 0x82, 0x99, 0x37,
-	0x71, 0xdf, 0x84, 0x63, 0x3c, 0xee, 0x5c, 0x0c, 0xbf, 0x5d, 0x00, 0xf0, 0x56, 0x2d, 0x09, 0x93,
-	0x65, 0x12, 0x31, 0xf8, 0x19, 0xb4, 0xb1, 0x8e, 0xff, 0x5f, 0x5a, 0xbf, 0xb9, 0xb9, 0xd3, 0x43,
-	0x5a, 0x2f, 0xce, 0xcd, 0xa8, 0xd7, 0xf2, 0x05, 0x5c, 0x4e, 0x25, 0x8f, 0x98, 0x52, 0xc7, 0xa7,
-	0xb8, 0xbd, 0xb3, 0xe2, 0x11, 0x6d, 0x8d, 0xce, 0xa1, 0x0f, 0x87, 0xb3, 0xee, 0x7f, 0xfd, 0xf3,
-	0xe3, 0xc6, 0x7c, 0xe5, 0xfd, 0xdc, 0xda, 0xe6, 0x66, 0x6b, 0x9b, 0xbf, 0xb7, 0xb6, 0xf9, 0x7d,
-	0x67, 0x1b, 0x9b, 0x9d, 0x6d, 0xfc, 0xda, 0xd9, 0xc6, 0x27, 0xb4, 0x4c, 0x74, 0x5c, 0xcc, 0x9d,
-	0x88, 0x67, 0x68, 0xce, 0x24, 0x8d, 0x62, 0x9a, 0xe4, 0xe8, 0x60, 0xdf, 0xda, 0x91, 0xab, 0xca,
-	0xc7, 0x7a, 0x2d, 0x98, 0x9a, 0xb7, 0x2a, 0x4f, 0x3e, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x93,
-	0x26, 0xf6, 0x2b, 0xe3, 0x02, 0x00, 0x00,
+	// 606 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x4f, 0xd4, 0x40,
+	0x14, 0xde, 0x22, 0x6e, 0xc2, 0x83, 0xac, 0x38, 0x6e, 0xf8, 0xd1, 0x40, 0x5d, 0x36, 0x31, 0x21,
+	0x1b, 0xd8, 0x02, 0x12, 0x0e, 0xdc, 0x60, 0xa9, 0xc9, 0x26, 0x82, 0x9b, 0xb6, 0xab, 0xc6, 0xcb,
+	0x66, 0x68, 0xc7, 0xb6, 0xc9, 0xb6, 0xd3, 0xcc, 0xcc, 0x36, 0x60, 0x3c, 0xa8, 0x7f, 0x81, 0x17,
+	0xff, 0x0f, 0x0e, 0xfe, 0x11, 0x1c, 0x89, 0x27, 0x4f, 0xc6, 0xc0, 0x01, 0xff, 0x0c, 0x43, 0x3b,
+	0x0b, 0xb8, 0x74, 0x15, 0x3c, 0x75, 0x66, 0xde, 0xf7, 0xbe, 0xf7, 0xf5, 0xcb, 0x97, 0x07, 0xf3,
+	0x31, 0xed, 0x62, 0x16, 0x70, 0x9d, 0x24, 0xa1, 0x9e, 0xac, 0xe2, 0x6e, 0xec, 0xe3, 0x55, 0x5d,
+	0x1c, 0xd4, 0x63, 0x46, 0x05, 0x45, 0x65, 0x59, 0xae, 0x93, 0x24, 0xac, 0xf7, 0xcb, 0xea, 0xb4,
+	0x43, 0x79, 0x48, 0xb9, 0x1e, 0x72, 0x4f, 0x4f, 0x56, 0x2f, 0x3e, 0x19, 0x5c, 0x9d, 0xcd, 0x0a,
+	0x9d, 0xf4, 0xa6, 0x67, 0x17, 0x59, 0x2a, 0x7b, 0xd4, 0xa3, 0xd9, 0xfb, 0xc5, 0x49, 0xbe, 0x2e,
+	0xe4, 0x8e, 0x8f, 0x31, 0xc3, 0xa1, 0x6c, 0xac, 0xae, 0x80, 0xfa, 0x8a, 0xe1, 0x38, 0x26, 0xae,
+	0x21, 0x7c, 0xc2, 0x48, 0x2f, 0xb4, 0x19, 0x8e, 0x38, 0x76, 0x44, 0x40, 0x23, 0x84, 0x60, 0xd4,
+	0xc5, 0x02, 0xcf, 0x28, 0x15, 0x65, 0x71, 0xc2, 0x4c, 0xcf, 0xd5, 0x25, 0x98, 0x92, 0x1d, 0x2d,
+	0x7c, 0xd8, 0xa5, 0xd8, 0x35, 0xa2, 0x84, 0x74, 0x69, 0x4c, 0x72, 0xd1, 0x15, 0xd0, 0xf2, 0xd1,
+	0x26, 0xe1, 0x31, 0x8d, 0x38, 0xa9, 0xbe, 0x86, 0xca, 0x70, 0x05, 0x26, 0xe1, 0xbd, 0xae, 0x40,
+	0xeb, 0x50, 0xe4, 0x02, 0x8b, 0x1e, 0x4f, 0xb9, 0x4b, 0x6b, 0x73, 0xf5, 0x3c, 0xe7, 0xea, 0x56,
+	0x8a, 0x31, 0x25, 0xb6, 0xfa, 0x45, 0x81, 0x07, 0xbb, 0xdc, 0x6b, 0xc7, 0x2e, 0x16, 0xa4, 0x95,
+	0xfe, 0x35, 0xda, 0x80, 0x31, 0xdc, 0x13, 0x3e, 0x65, 0x81, 0x38, 0x4c, 0xc9, 0xc6, 0xb6, 0x67,
+	0xbe, 0x7d, 0x5d, 0x2e, 0x4b, 0x37, 0xb7, 0x5c, 0x97, 0x11, 0xce, 0x2d, 0xc1, 0x82, 0xc8, 0x33,
+	0xaf, 0xa0, 0x68, 0x13, 0x8a, 0x99, 0x6f, 0x33, 0x23, 0x15, 0x65, 0x71, 0x7c, 0x98, 0x82, 0x6c,
+	0xca, 0xf6, 0xe8, 0xf1, 0x8f, 0xc7, 0x05, 0x53, 0x76, 0x6c, 0x96, 0x3e, 0x9d, 0x1f, 0xd5, 0xae,
+	0xb8, 0xaa, 0xb3, 0x30, 0x3d, 0x20, 0xeb, 0xd2, 0x8c, 0x8f, 0x0a, 0x94, 0x2f, 0x6b, 0x0d, 0x1f,
+	0x07, 0x51, 0x83, 0x46, 0x6f, 0x03, 0xef, 0xbf, 0x75, 0x2f, 0xc0, 0x84, 0x73, 0x41, 0xd3, 0x71,
+	0x52, 0x9e, 0x54, 0xfd, 0x98, 0x39, 0xee, 0x5c, 0x51, 0xdf, 0x90, 0xa7, 0xc1, 0x5c, 0x9e, 0x84,
+	0xbe, 0xc6, 0x9a, 0x0d, 0xc5, 0xcc, 0x68, 0x34, 0x0f, 0xb3, 0x96, 0xbd, 0x65, 0xb7, 0xad, 0x8e,
+	0x69, 0xbc, 0x34, 0x4c, 0xbb, 0xd3, 0xde, 0xb3, 0x5a, 0x46, 0xa3, 0xf9, 0xac, 0x69, 0xec, 0x4c,
+	0x16, 0x10, 0x82, 0x92, 0x2c, 0x5b, 0xed, 0x46, 0xc3, 0xb0, 0xac, 0x49, 0x05, 0x4d, 0xc3, 0x23,
+	0xf9, 0xb6, 0xf7, 0xc2, 0xee, 0x34, 0xf7, 0x1a, 0xcf, 0xdb, 0x3b, 0xc6, 0xce, 0xe4, 0xc8, 0xda,
+	0xaf, 0x7b, 0x00, 0xbb, 0xdc, 0xb3, 0x08, 0x4b, 0x02, 0x87, 0xa0, 0x77, 0x50, 0x32, 0x84, 0x7f,
+	0x3d, 0x8b, 0x2b, 0xf9, 0x8e, 0x0f, 0xcf, 0x8e, 0xba, 0x71, 0xd7, 0x0e, 0x99, 0xb6, 0xf7, 0x30,
+	0xd5, 0x62, 0xd4, 0x21, 0x9c, 0x0f, 0x26, 0x7c, 0xe9, 0xaf, 0x8c, 0x03, 0x68, 0x75, 0xfd, 0x2e,
+	0xe8, 0xbe, 0xbd, 0xc8, 0x85, 0x89, 0x3f, 0x12, 0xfb, 0x24, 0x9f, 0x65, 0x20, 0x41, 0xea, 0xf2,
+	0xad, 0x60, 0x97, 0x53, 0x38, 0x3c, 0xbc, 0x19, 0xb2, 0xda, 0x3f, 0x38, 0xae, 0x61, 0xd5, 0xb5,
+	0xdb, 0x63, 0xfb, 0x43, 0xd5, 0xfb, 0x1f, 0xce, 0x8f, 0x6a, 0xca, 0x76, 0xf3, 0xf8, 0x54, 0x53,
+	0x4e, 0x4e, 0x35, 0xe5, 0xe7, 0xa9, 0xa6, 0x7c, 0x3e, 0xd3, 0x0a, 0x27, 0x67, 0x5a, 0xe1, 0xfb,
+	0x99, 0x56, 0x78, 0xa3, 0x7b, 0x81, 0xf0, 0x7b, 0xfb, 0x75, 0x87, 0x86, 0xfa, 0x3e, 0x61, 0x38,
+	0x8d, 0xa9, 0xde, 0xdf, 0x62, 0x72, 0x2f, 0x1e, 0xa4, 0xeb, 0x4c, 0x1c, 0xc6, 0x84, 0xef, 0x17,
+	0xd3, 0x2d, 0xf6, 0xf4, 0xf7, 0x00, 0x9a, 0x28, 0x14, 0x02, 0x69, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -292,6 +495,10 @@ type MsgServiceClient interface {
 	EthTransaction(ctx context.Context, in *WrappedEthereumTransaction, opts ...grpc.CallOption) (*WrappedEthereumTransactionResult, error)
 	// ProcessPayloadEnvelope defines a method to process CL paylods.
 	ProcessPayloadEnvelope(ctx context.Context, in *WrappedPayloadEnvelope, opts ...grpc.CallOption) (*WrappedPayloadEnvelopeResponse, error)
+	// UpdateParams defines a governance operation to update the x/evm module parameters.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateChainConfig defines a governance operation to schedule forks in the chain config.
+	UpdateChainConfig(ctx context.Context, in *MsgUpdateChainConfig, opts ...grpc.CallOption) (*MsgUpdateChainConfigResponse, error)
 }
 
 type msgServiceClient struct {
@@ -320,12 +527,34 @@ func (c *msgServiceClient) ProcessPayloadEnvelope(ctx context.Context, in *Wrapp
 	return out, nil
 }
 
+func (c *msgServiceClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/polaris.evm.v1alpha1.MsgService/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) UpdateChainConfig(ctx context.Context, in *MsgUpdateChainConfig, opts ...grpc.CallOption) (*MsgUpdateChainConfigResponse, error) {
+	out := new(MsgUpdateChainConfigResponse)
+	err := c.cc.Invoke(ctx, "/polaris.evm.v1alpha1.MsgService/UpdateChainConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServiceServer is the server API for MsgService service.
 type MsgServiceServer interface {
 	// EthTransaction defines a method submitting Ethereum transactions.
 	EthTransaction(context.Context, *WrappedEthereumTransaction) (*WrappedEthereumTransactionResult, error)
 	// ProcessPayloadEnvelope defines a method to process CL paylods.
 	ProcessPayloadEnvelope(context.Context, *WrappedPayloadEnvelope) (*WrappedPayloadEnvelopeResponse, error)
+	// UpdateParams defines a governance operation to update the x/evm module parameters.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdateChainConfig defines a governance operation to schedule forks in the chain config.
+	UpdateChainConfig(context.Context, *MsgUpdateChainConfig) (*MsgUpdateChainConfigResponse, error)
 }
 
 // UnimplementedMsgServiceServer can be embedded to have forward compatible implementations.
@@ -338,6 +567,12 @@ func (*UnimplementedMsgServiceServer) EthTransaction(ctx context.Context, req *W
 func (*UnimplementedMsgServiceServer) ProcessPayloadEnvelope(ctx context.Context, req *WrappedPayloadEnvelope) (*WrappedPayloadEnvelopeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProcessPayloadEnvelope not implemented")
 }
+func (*UnimplementedMsgServiceServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServiceServer) UpdateChainConfig(ctx context.Context, req *MsgUpdateChainConfig) (*MsgUpdateChainConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChainConfig not implemented")
+}
 
 func RegisterMsgServiceServer(s grpc1.Server, srv MsgServiceServer) {
 	s.RegisterService(&_MsgService_serviceDesc, srv)
@@ -379,6 +614,42 @@ func _MsgService_ProcessPayloadEnvelope_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polaris.evm.v1alpha1.MsgService/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_UpdateChainConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateChainConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).UpdateChainConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polaris.evm.v1alpha1.MsgService/UpdateChainConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).UpdateChainConfig(ctx, req.(*MsgUpdateChainConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MsgService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "polaris.evm.v1alpha1.MsgService",
 	HandlerType: (*MsgServiceServer)(nil),
@@ -391,6 +662,14 @@ var _MsgService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ProcessPayloadEnvelope",
 			Handler:    _MsgService_ProcessPayloadEnvelope_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _MsgService_UpdateParams_Handler,
+		},
+		{
+			MethodName: "UpdateChainConfig",
+			Handler:    _MsgService_UpdateChainConfig_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "polaris/evm/v1alpha1/tx.proto",
@@ -507,6 +786,129 @@ func (m *WrappedEthereumTransactionResult) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateChainConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateChainConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateChainConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainConfig) > 0 {
+		i -= len(m.ChainConfig)
+		copy(dAtA[i:], m.ChainConfig)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainConfig)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateChainConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateChainConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateChainConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -565,13 +967,63 @@ func (m *WrappedEthereumTransactionResult) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func (m *WrappedEthereumTransaction) Unmarshal(dAtA []byte) error {
+
+func (m *MsgUpdateChainConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainConfig)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateChainConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *WrappedEthereumTransaction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -858,6 +1310,335 @@ func (m *WrappedEthereumTransactionResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateChainConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateChainConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateChainConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainConfig", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainConfig = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateChainConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateChainConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateChainConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -95,6 +95,7 @@ ARROW_GLACIER_BLOCK=$(jq '.config.arrowGlacierBlock' $ETH_GENESIS_JSON)
 GRAY_GLACIER_BLOCK=$(jq '.config.grayGlacierBlock' $ETH_GENESIS_JSON)
 MERGE_NETSPLIT_BLOCK=$(jq '.config.mergeNetsplitBlock' $ETH_GENESIS_JSON)
 SHANGHAI_TIME=$(jq '.config.shanghaiTime' $ETH_GENESIS_JSON)
+CANCUN_TIME=$(jq '.config.cancunTime' $ETH_GENESIS_JSON)
 TERMINAL_TOTAL_DIFFICULTY=$(jq '.config.terminalTotalDifficulty' $ETH_GENESIS_JSON)
 TERMINAL_TOTAL_DIFFICULTY_PASSED=$(jq '.config.terminalTotalDifficultyPassed' $ETH_GENESIS_JSON)
 
@@ -117,6 +118,7 @@ sed -i "s/arrow-glacier-block = .*/arrow-glacier-block = \"$ARROW_GLACIER_BLOCK\
 sed -i "s/gray-glacier-block = .*/gray-glacier-block = \"$GRAY_GLACIER_BLOCK\"/" $APP_TOML
 sed -i "s/merge-netsplit-block = .*/merge-netsplit-block = \"$MERGE_NETSPLIT_BLOCK\"/" $APP_TOML
 sed -i "s/shanghai-time = .*/shanghai-time = \"$SHANGHAI_TIME\"/" $APP_TOML
+sed -i "s/cancun-time = .*/cancun-time = \"$CANCUN_TIME\"/" $APP_TOML
 sed -i "s/terminal-total-difficulty = .*/terminal-total-difficulty = \"$TERMINAL_TOTAL_DIFFICULTY\"/" $APP_TOML
 sed -i "s/terminal-total-difficulty-passed = .*/terminal-total-difficulty-passed = $TERMINAL_TOTAL_DIFFICULTY_PASSED/" $APP_TOML
 
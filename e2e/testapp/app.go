@@ -21,6 +21,8 @@
 package testapp
 
 import (
+	"context"
+	"errors"
 	"io"
 	"os"
 	"path/filepath"
@@ -39,7 +41,6 @@ import (
 	signinglib "github.com/berachain/polaris/cosmos/lib/signing"
 	polarruntime "github.com/berachain/polaris/cosmos/runtime"
 	"github.com/berachain/polaris/cosmos/runtime/ante"
-	"github.com/berachain/polaris/cosmos/runtime/miner"
 	evmkeeper "github.com/berachain/polaris/cosmos/x/evm/keeper"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -190,9 +191,12 @@ func NewPolarisApp(
 
 	// Build the app using the app builder.
 	app.App = appBuilder.Build(db, traceStore, baseAppOptions...)
-	app.Polaris = polarruntime.New(app,
+	var err error
+	if app.Polaris, err = polarruntime.New(app,
 		evmconfig.MustReadConfigFromAppOpts(appOpts), app.Logger(), app.EVMKeeper.Host, nil,
-	)
+	); err != nil {
+		panic(err)
+	}
 
 	// Build cosmos ante handler for non-evm transactions.
 	cosmHandler, err := authante.NewAnteHandler(
@@ -212,9 +216,7 @@ func NewPolarisApp(
 	}
 
 	// Setup Polaris Runtime.
-	if err = app.Polaris.Build(
-		app, cosmHandler, app.EVMKeeper, miner.DefaultAllowedMsgs,
-	); err != nil {
+	if err = app.Polaris.Build(app, cosmHandler, app.EVMKeeper); err != nil {
 		panic(err)
 	}
 
@@ -288,6 +290,15 @@ func (app *SimApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICon
 	if err := app.Polaris.SetupServices(apiSvr.ClientCtx); err != nil {
 		panic(err)
 	}
+
+	// The services start once a block is committed, so a failure to start them is only known
+	// then, and is reported by the health endpoint as well. Closing the app first is no failure.
+	go func() {
+		if err := app.Polaris.WaitForStart(context.Background()); err != nil &&
+			!errors.Is(err, context.Canceled) {
+			app.Logger().Error("failed to start polaris services", "err", err)
+		}
+	}()
 }
 
 // Close shuts down the application.
@@ -128,6 +128,7 @@ func MakeAppConfig(bech32Prefix string) depinject.Config {
 					},
 					PreBlockers: []string{
 						upgradetypes.ModuleName,
+						evmtypes.ModuleName,
 					},
 					BeginBlockers: []string{
 						minttypes.ModuleName,
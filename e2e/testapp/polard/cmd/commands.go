@@ -21,7 +21,9 @@
 package cmd
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"os"
 
@@ -33,6 +35,7 @@ import (
 	confixcmd "cosmossdk.io/tools/confix/cmd"
 
 	evmconfig "github.com/berachain/polaris/cosmos/config"
+	"github.com/berachain/polaris/cosmos/x/evm"
 	testapp "github.com/berachain/polaris/e2e/testapp"
 
 	cmtcfg "github.com/cometbft/cometbft/config"
@@ -54,6 +57,7 @@ import (
 	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 )
 
 // initCometBFTConfig helps to override default CometBFT Config values.
@@ -146,12 +150,50 @@ func genesisCommand(
 ) *cobra.Command {
 	cmd := genutilcli.Commands(txConfig, basicManager, testapp.DefaultNodeHome)
 
+	for _, subCmd := range cmd.Commands() {
+		if subCmd.Name() == "validate" {
+			validateEVMGenesisAccounts(subCmd)
+		}
+	}
 	for _, subCmd := range cmds {
 		cmd.AddCommand(subCmd)
 	}
 	return cmd
 }
 
+// validateEVMGenesisAccounts extends the `polard genesis validate` command to check the evm
+// genesis accounts against the auth genesis accounts, which the modules cannot do on their own.
+// The chunks of the genesis alloc are read from the genesis alloc directory of the node.
+func validateEVMGenesisAccounts(validateCmd *cobra.Command) {
+	runE := validateCmd.RunE
+	validateCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		serverCtx := server.GetServerContextFromCmd(cmd)
+		genesis := serverCtx.Config.GenesisFile()
+		if len(args) > 0 {
+			genesis = args[0]
+		}
+		appGenesis, err := genutiltypes.AppGenesisFromFile(genesis)
+		if err != nil {
+			return err
+		}
+		cfg, err := evmconfig.ReadConfigFromAppOpts(serverCtx.Viper)
+		if err != nil {
+			return err
+		}
+
+		var appState map[string]json.RawMessage
+		if err = json.Unmarshal(appGenesis.AppState, &appState); err != nil {
+			return fmt.Errorf("error unmarshalling genesis doc %s: %w", genesis, err)
+		}
+		if err = evm.ValidateGenesisAccounts(
+			client.GetClientContextFromCmd(cmd).Codec, appState, cfg.Polar.Genesis.AllocDir,
+		); err != nil {
+			return fmt.Errorf("error validating genesis file %s: %w", genesis, err)
+		}
+		return runE(cmd, args)
+	}
+}
+
 func queryCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                        "query",
@@ -0,0 +1,307 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"context"
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/bitutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/bloombits"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+const (
+	// bloomServiceThreads is the number of goroutines used to service bloombits lookups for all
+	// running filters.
+	bloomServiceThreads = 16
+	// bloomFilterThreads is the number of goroutines used per filter to multiplex requests onto
+	// the servicing goroutines.
+	bloomFilterThreads = 3
+	// bloomRetrievalBatch is the maximum number of bloom bit retrievals to service in a batch.
+	bloomRetrievalBatch = 16
+	// bloomRetrievalWait is the maximum time to wait for a batch of bloom bit requests.
+	bloomRetrievalWait = time.Duration(0)
+	// chainHeadChanSize is the size of the channel listening to chain head events.
+	chainHeadChanSize = 10
+)
+
+var (
+	// bloomSectionsKey tracks the number of indexed bloombits sections.
+	bloomSectionsKey = []byte("polaris-bloombits-sections")
+	// bloomSectionHeadPrefix + section (uint64 big endian) -> hash of the last block of section.
+	bloomSectionHeadPrefix = []byte("polaris-bloombits-head")
+	// fullBloom matches every filter, it stands in for blocks whose header is unavailable.
+	fullBloom = func() ethtypes.Bloom {
+		var bloom ethtypes.Bloom
+		for i := range bloom {
+			bloom[i] = 0xff
+		}
+		return bloom
+	}()
+)
+
+// BloomIndexerChain defines the methods of the chain that the bloom indexer reads from.
+type BloomIndexerChain interface {
+	CurrentBlock() *ethtypes.Header
+	GetHeaderByNumber(uint64) *ethtypes.Header
+	GetBlockByNumber(uint64) *ethtypes.Block
+	SubscribeChainHeadEvent(chan<- core.ChainHeadEvent) event.Subscription
+}
+
+// BloomIndexer builds a rotated bloombits index over the header blooms of the committed blocks
+// of the chain, one section of `params.BloomBitsBlocks` blocks at a time, and serves the
+// bloombits retrievals of log filters from it.
+//
+// The indexer follows chain head events, but reads the headers to index from the chain, so that
+// it catches up on blocks committed while it was not running. Blocks whose header is no longer
+// available are indexed with a bloom that matches every filter, which only costs the filter a
+// lookup of their receipts.
+type BloomIndexer struct {
+	db   ethdb.KeyValueStore
+	size uint64
+
+	mu       sync.RWMutex
+	sections uint64 // number of sections that have been indexed
+
+	gen  *bloombits.Generator // generator of the section being indexed
+	next uint64               // number of the next block to index
+
+	update   chan struct{}
+	requests chan chan *bloombits.Retrieval
+	quit     chan struct{}
+	wg       sync.WaitGroup
+}
+
+// NewBloomIndexer creates a bloom indexer that persists the index in the given database.
+func NewBloomIndexer(db ethdb.KeyValueStore) *BloomIndexer {
+	b := &BloomIndexer{
+		db:       db,
+		size:     params.BloomBitsBlocks,
+		update:   make(chan struct{}, 1),
+		requests: make(chan chan *bloombits.Retrieval),
+		quit:     make(chan struct{}),
+	}
+	if bz, err := db.Get(bloomSectionsKey); err == nil && len(bz) == 8 {
+		b.sections = binary.BigEndian.Uint64(bz)
+	}
+	b.next = b.sections * b.size
+	return b
+}
+
+// Start starts indexing the blocks of the chain and servicing bloombits retrievals.
+func (b *BloomIndexer) Start(chain BloomIndexerChain) {
+	headCh := make(chan core.ChainHeadEvent, chainHeadChanSize)
+	sub := chain.SubscribeChainHeadEvent(headCh)
+
+	b.wg.Add(2)
+	go b.eventLoop(headCh, sub)
+	go b.indexLoop(chain)
+	for i := 0; i < bloomServiceThreads; i++ {
+		b.wg.Add(1)
+		go b.serviceLoop()
+	}
+
+	// Catch up on the blocks committed before the indexer was started.
+	b.signal()
+}
+
+// Close stops the indexer and closes its database.
+func (b *BloomIndexer) Close() error {
+	close(b.quit)
+	b.wg.Wait()
+	return b.db.Close()
+}
+
+// BloomStatus returns the number of blocks per section and the number of indexed sections.
+func (b *BloomIndexer) BloomStatus() (uint64, uint64) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.size, b.sections
+}
+
+// ServiceFilter multiplexes the bloombits retrievals of the given matcher session onto the
+// servicing goroutines of the indexer.
+func (b *BloomIndexer) ServiceFilter(_ context.Context, session *bloombits.MatcherSession) {
+	for i := 0; i < bloomFilterThreads; i++ {
+		go session.Multiplex(bloomRetrievalBatch, bloomRetrievalWait, b.requests)
+	}
+}
+
+// eventLoop wakes up the indexer on every new chain head, without ever blocking the chain.
+func (b *BloomIndexer) eventLoop(headCh <-chan core.ChainHeadEvent, sub event.Subscription) {
+	defer b.wg.Done()
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case <-b.quit:
+			return
+		case <-sub.Err():
+			return
+		case <-headCh:
+			b.signal()
+		}
+	}
+}
+
+// signal notifies the index loop that there are new blocks to index.
+func (b *BloomIndexer) signal() {
+	select {
+	case b.update <- struct{}{}:
+	default:
+	}
+}
+
+// indexLoop indexes the blocks of the chain up to its current head whenever it is signaled.
+func (b *BloomIndexer) indexLoop(chain BloomIndexerChain) {
+	defer b.wg.Done()
+
+	for {
+		select {
+		case <-b.quit:
+			return
+		case <-b.update:
+			head := chain.CurrentBlock()
+			if head == nil {
+				continue
+			}
+			for b.next <= head.Number.Uint64() {
+				select {
+				case <-b.quit:
+					return
+				default:
+				}
+				if err := b.process(chain, b.next); err != nil {
+					log.Error("failed to index bloombits", "number", b.next, "err", err)
+					break
+				}
+			}
+		}
+	}
+}
+
+// process adds the header bloom of the block with the given number to the section being
+// indexed, and commits the section once it is complete.
+func (b *BloomIndexer) process(chain BloomIndexerChain, number uint64) error {
+	section := number / b.size
+	if b.gen == nil {
+		gen, err := bloombits.NewGenerator(uint(b.size))
+		if err != nil {
+			return err
+		}
+		b.gen = gen
+	}
+
+	bloom, hash := fullBloom, common.Hash{}
+	if header := chain.GetHeaderByNumber(number); header != nil {
+		bloom, hash = header.Bloom, header.Hash()
+	} else if block := chain.GetBlockByNumber(number); block != nil {
+		bloom, hash = block.Bloom(), block.Hash()
+	}
+	if err := b.gen.AddBloom(uint(number-section*b.size), bloom); err != nil {
+		return err
+	}
+	b.next++
+
+	if b.next%b.size == 0 {
+		if err := b.commit(section, hash); err != nil {
+			return err
+		}
+		b.gen = nil
+	}
+	return nil
+}
+
+// commit writes the bloombits of a complete section, keyed by the hash of its last block.
+func (b *BloomIndexer) commit(section uint64, head common.Hash) error {
+	batch := b.db.NewBatchWithSize((int(b.size) / 8) * ethtypes.BloomBitLength)
+	for i := 0; i < ethtypes.BloomBitLength; i++ {
+		bits, err := b.gen.Bitset(uint(i))
+		if err != nil {
+			return err
+		}
+		rawdb.WriteBloomBits(batch, uint(i), section, head, bitutil.CompressBytes(bits))
+	}
+	if err := batch.Put(bloomSectionHeadKey(section), head.Bytes()); err != nil {
+		return err
+	}
+	if err := batch.Put(bloomSectionsKey, binary.BigEndian.AppendUint64(nil, section+1)); err != nil {
+		return err
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	b.sections = section + 1
+	b.mu.Unlock()
+	return nil
+}
+
+// serviceLoop serves bloombits retrievals from the index.
+func (b *BloomIndexer) serviceLoop() {
+	defer b.wg.Done()
+
+	for {
+		select {
+		case <-b.quit:
+			return
+		case request := <-b.requests:
+			task := <-request
+			task.Bitsets = make([][]byte, len(task.Sections))
+			for i, section := range task.Sections {
+				task.Bitsets[i], task.Error = b.bitset(task.Bit, section)
+				if task.Error != nil {
+					break
+				}
+			}
+			request <- task
+		}
+	}
+}
+
+// bitset returns the decompressed bit vector of the given bloom bit in the given section.
+func (b *BloomIndexer) bitset(bit uint, section uint64) ([]byte, error) {
+	head, err := b.db.Get(bloomSectionHeadKey(section))
+	if err != nil {
+		return nil, err
+	}
+	compVector, err := rawdb.ReadBloomBits(b.db, bit, section, common.BytesToHash(head))
+	if err != nil {
+		return nil, err
+	}
+	return bitutil.DecompressBytes(compVector, int(b.size/8))
+}
+
+// bloomSectionHeadKey returns the key of the hash of the last block of the given section.
+func bloomSectionHeadKey(section uint64) []byte {
+	return binary.BigEndian.AppendUint64(append([]byte{}, bloomSectionHeadPrefix...), section)
+}
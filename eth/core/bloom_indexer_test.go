@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/berachain/polaris/eth/core"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethcore "github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/bloombits"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/params"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// bloomChain is a chain of headers for the bloom indexer to index.
+type bloomChain struct {
+	headers []*ethtypes.Header
+	feed    event.Feed
+}
+
+func (c *bloomChain) CurrentBlock() *ethtypes.Header {
+	return c.headers[len(c.headers)-1]
+}
+
+func (c *bloomChain) GetHeaderByNumber(number uint64) *ethtypes.Header {
+	if number >= uint64(len(c.headers)) {
+		return nil
+	}
+	return c.headers[number]
+}
+
+func (c *bloomChain) GetBlockByNumber(uint64) *ethtypes.Block {
+	return nil
+}
+
+func (c *bloomChain) SubscribeChainHeadEvent(
+	ch chan<- ethcore.ChainHeadEvent,
+) event.Subscription {
+	return c.feed.Subscribe(ch)
+}
+
+var _ = Describe("BloomIndexer", func() {
+	var (
+		chain   *bloomChain
+		indexer *core.BloomIndexer
+		address = common.Address{0x1}
+		matches = map[uint64]bool{7: true, 100: true, params.BloomBitsBlocks - 1: true}
+	)
+
+	BeforeEach(func() {
+		chain = &bloomChain{}
+		for i := uint64(0); i < params.BloomBitsBlocks+10; i++ {
+			header := &ethtypes.Header{Number: new(big.Int).SetUint64(i)}
+			if matches[i] {
+				header.Bloom = ethtypes.BytesToBloom(ethtypes.LogsBloom([]*ethtypes.Log{
+					{Address: address},
+				}))
+			}
+			chain.headers = append(chain.headers, header)
+		}
+		indexer = core.NewBloomIndexer(rawdb.NewMemoryDatabase())
+		indexer.Start(chain)
+	})
+
+	AfterEach(func() {
+		Expect(indexer.Close()).To(Succeed())
+	})
+
+	It("should index complete sections and serve filters from them", func() {
+		Eventually(func() uint64 {
+			_, sections := indexer.BloomStatus()
+			return sections
+		}).Should(Equal(uint64(1)))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		results := make(chan uint64, params.BloomBitsBlocks)
+		matcher := bloombits.NewMatcher(params.BloomBitsBlocks, [][][]byte{{address.Bytes()}})
+		session, err := matcher.Start(ctx, 0, params.BloomBitsBlocks-1, results)
+		Expect(err).ToNot(HaveOccurred())
+		defer session.Close()
+		indexer.ServiceFilter(ctx, session)
+
+		found := make(map[uint64]bool)
+		for number := range results {
+			found[number] = true
+		}
+		Expect(session.Error()).ToNot(HaveOccurred())
+		Expect(found).To(Equal(matches))
+	})
+})
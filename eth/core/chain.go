@@ -24,6 +24,7 @@ import (
 	"context"
 	"errors"
 	"math/big"
+	"sync"
 	"sync/atomic"
 
 	"github.com/berachain/polaris/eth/consensus"
@@ -32,8 +33,10 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/lru"
 	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
@@ -65,15 +68,23 @@ type blockchain struct {
 	pp  PrecompilePlugin
 	spf StatePluginFactory
 
-	engine    consensus.Engine
-	processor core.Processor
-	validator core.Validator
+	engine consensus.Engine
 
 	// vmConfig is the configuration used to create the EVM.
 	vmConfig *vm.Config
 
-	// config represents the chain config.
-	config *params.ChainConfig
+	// configMu guards the chain config, which is swapped when the host chain adopts a new one,
+	// and the processor and validator that are built against it.
+	configMu  sync.RWMutex
+	config    *params.ChainConfig
+	processor core.Processor
+	validator core.Validator
+
+	// retention is the policy that historical data is pruned by.
+	retention RetentionPolicy
+
+	// badBlocks is a bounded store of the blocks that failed to be inserted, kept for debugging.
+	badBlocks ethdb.Database
 
 	// currentBlock is the current/pending block.
 	currentBlock atomic.Pointer[ethtypes.Block]
@@ -116,7 +127,6 @@ func NewChain(
 		hp:             host.GetHistoricalPlugin(),
 		pp:             host.GetPrecompilePlugin(),
 		spf:            host.GetStatePluginFactory(),
-		config:         config,
 		vmConfig:       &vm.Config{},
 		receiptsCache:  lru.NewCache[common.Hash, ethtypes.Receipts](defaultCacheSize),
 		blockNumCache:  lru.NewCache[uint64, *ethtypes.Block](defaultCacheSize),
@@ -126,9 +136,9 @@ func NewChain(
 		scope:          event.SubscriptionScope{},
 		logger:         log.Root(),
 		engine:         engine,
+		badBlocks:      rawdb.NewMemoryDatabase(),
 	}
-	bc.processor = core.NewStateProcessor(bc.config, bc, bc.engine)
-	bc.validator = core.NewBlockValidator(bc.config, bc, bc.engine)
+	bc.SetConfig(config)
 	// TODO: hmm...
 	bc.currentBlock.Store(
 		ethtypes.NewBlock(&ethtypes.Header{Time: 0, Number: big.NewInt(0),
@@ -165,6 +175,21 @@ func (bc *blockchain) loadLastState(number uint64) error {
 	return nil
 }
 
+// SetRetentionPolicy sets the policy that historical data is pruned by as new blocks are written.
+func (bc *blockchain) SetRetentionPolicy(retention RetentionPolicy) {
+	bc.retention = retention
+}
+
+// SetConfig swaps the chain config that blocks are processed and validated with. The chain config
+// is not modified in place, as it is read concurrently.
+func (bc *blockchain) SetConfig(config *params.ChainConfig) {
+	bc.configMu.Lock()
+	defer bc.configMu.Unlock()
+	bc.config = config
+	bc.processor = core.NewStateProcessor(config, bc, bc.engine)
+	bc.validator = core.NewBlockValidator(config, bc, bc.engine)
+}
+
 func (bc *blockchain) StatePluginFactory() StatePluginFactory {
 	return bc.spf
 }
@@ -39,3 +39,25 @@ func (bc *blockchain) deriveReceipts(
 	}
 	return types.DeriveReceiptsFromBlock(bc.Config(), receipts, block)
 }
+
+// evictPruned removes the blocks up to the given number, as well as their receipts and tx
+// lookup entries, from the caches after they have been pruned from the historical plugin.
+func (bc *blockchain) evictPruned(number uint64) {
+	for _, n := range bc.blockNumCache.Keys() {
+		if n > number {
+			continue
+		}
+		block, ok := bc.blockNumCache.Peek(n)
+		bc.blockNumCache.Remove(n)
+		if !ok {
+			continue
+		}
+		bc.blockHashCache.Remove(block.Hash())
+		bc.receiptsCache.Remove(block.Hash())
+		if !bc.retention.KeepReceipts() {
+			for _, tx := range block.Transactions() {
+				bc.txLookupCache.Remove(tx.Hash())
+			}
+		}
+	}
+}
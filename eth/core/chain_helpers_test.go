@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/lru"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("evictPruned", func() {
+	It("should evict every cached block up to the pruned number", func() {
+		bc := &blockchain{
+			receiptsCache:  lru.NewCache[common.Hash, ethtypes.Receipts](defaultCacheSize),
+			blockNumCache:  lru.NewCache[uint64, *ethtypes.Block](defaultCacheSize),
+			blockHashCache: lru.NewCache[common.Hash, *ethtypes.Block](defaultCacheSize),
+			retention:      RetentionPolicy{Mode: RetentionRecent, Blocks: 2},
+		}
+		blocks := make([]*ethtypes.Block, 5)
+		for i := range blocks {
+			blocks[i] = ethtypes.NewBlockWithHeader(&ethtypes.Header{Number: big.NewInt(int64(i + 1))})
+			bc.blockNumCache.Add(blocks[i].NumberU64(), blocks[i])
+			bc.blockHashCache.Add(blocks[i].Hash(), blocks[i])
+			bc.receiptsCache.Add(blocks[i].Hash(), ethtypes.Receipts{})
+		}
+
+		// A history that predates the retention policy is evicted along with the pruned block.
+		bc.evictPruned(3)
+		for _, block := range blocks[:3] {
+			Expect(bc.blockNumCache.Contains(block.NumberU64())).To(BeFalse())
+			Expect(bc.blockHashCache.Contains(block.Hash())).To(BeFalse())
+			Expect(bc.receiptsCache.Contains(block.Hash())).To(BeFalse())
+		}
+		for _, block := range blocks[3:] {
+			Expect(bc.blockNumCache.Contains(block.NumberU64())).To(BeTrue())
+			Expect(bc.blockHashCache.Contains(block.Hash())).To(BeTrue())
+		}
+	})
+})
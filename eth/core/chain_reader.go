@@ -21,13 +21,16 @@
 package core
 
 import (
+	"errors"
 	"math/big"
 
 	"github.com/berachain/polaris/eth/core/types"
 	"github.com/berachain/polaris/lib/utils"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
 )
 
 // ChainReader defines methods that are used to read the state and blocks of the chain.
@@ -51,6 +54,9 @@ type ChainBlockReader interface {
 	GetTransactionLookup(common.Hash) *types.TxLookupEntry
 	GetTd(common.Hash, uint64) *big.Int
 	HasBlock(common.Hash, uint64) bool
+	HistoryPruned(common.Hash, uint64) bool
+	BadBlocks() []*ethtypes.Block
+	BadBlockDB() ethdb.Database
 }
 
 // =========================================================================
@@ -202,15 +208,10 @@ func (bc *blockchain) GetReceiptsByHash(blockHash common.Hash) ethtypes.Receipts
 		return nil
 	}
 
-	// cache the found receipts for next time and return
+	// cache the found receipts for next time and return, the historical plugin has already
+	// derived their fields, which does not require the block body to still be retained.
 	bc.receiptsCache.Add(blockHash, receipts)
-	derived, err := bc.deriveReceipts(receipts, blockHash)
-	if err != nil {
-		bc.logger.Error("failed to derive receipts", "err", err)
-		return nil
-	}
-
-	return derived
+	return receipts
 }
 
 // GetTransaction gets a transaction by hash. It also returns the block hash of the
@@ -255,12 +256,10 @@ func (bc *blockchain) GetHeaderByHash(hash common.Hash) *ethtypes.Header {
 	header, err := bc.bp.GetHeaderByHash(hash)
 	if err != nil && bc.hp != nil {
 		// try searching the historical plugin if the block plugin does not have the header
-		var block *ethtypes.Block
-		block, err = bc.hp.GetBlockByHash(hash)
+		header, err = bc.hp.GetHeaderByHash(hash)
 		if err != nil {
 			return nil
 		}
-		header = block.Header()
 	}
 
 	return header
@@ -285,3 +284,30 @@ func (bc *blockchain) HasBlock(hash common.Hash, number uint64) bool {
 	}
 	return b != nil
 }
+
+// HistoryPruned returns true if the historical data of the block with the given hash, or the
+// given number if the hash is empty, has been pruned by the retention policy.
+func (bc *blockchain) HistoryPruned(hash common.Hash, number uint64) bool {
+	if bc.hp == nil {
+		return false
+	}
+
+	var err error
+	if hash != (common.Hash{}) {
+		_, err = bc.hp.GetBlockByHash(hash)
+	} else {
+		_, err = bc.hp.GetBlockByNumber(number)
+	}
+	return errors.Is(err, ErrBlockPruned)
+}
+
+// BadBlocks returns the most recent blocks that failed to be inserted, newest first.
+func (bc *blockchain) BadBlocks() []*ethtypes.Block {
+	return rawdb.ReadAllBadBlocks(bc.badBlocks)
+}
+
+// BadBlockDB returns the database of the bounded bad block store, which is laid out as the bad
+// blocks of a geth chain database.
+func (bc *blockchain) BadBlockDB() ethdb.Database {
+	return bc.badBlocks
+}
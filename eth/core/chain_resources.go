@@ -198,5 +198,7 @@ func (bc *blockchain) GetVMConfig() *vm.Config {
 
 // Config returns the Ethereum chain config from the host chain.
 func (bc *blockchain) Config() *params.ChainConfig {
+	bc.configMu.RLock()
+	defer bc.configMu.RUnlock()
 	return bc.config
 }
@@ -28,8 +28,10 @@ import (
 	"github.com/berachain/polaris/eth/core/types"
 
 	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
 )
 
 // ChainWriter defines methods that are used to perform state and block transitions.
@@ -44,6 +46,9 @@ type ChainWriter interface {
 	InsertBlockWithoutSetHead(block *ethtypes.Block) error
 	WriteBlockAndSetHead(block *ethtypes.Block, receipts []*ethtypes.Receipt, logs []*ethtypes.Log,
 		state state.StateDB, emitHeadEvent bool) (status core.WriteStatus, err error)
+	SetRetentionPolicy(retention RetentionPolicy)
+	SetConfig(config *params.ChainConfig)
+	ReportBadBlock(block *ethtypes.Block, err error)
 }
 
 // WriteGenesisBlockWithContext inserts the genesis block
@@ -85,32 +90,48 @@ func (bc *blockchain) InsertBlockWithoutSetHead(block *ethtypes.Block) error {
 func (bc *blockchain) insertBlockWithoutSetHead(
 	block *ethtypes.Block, state state.StateDB,
 ) ([]*ethtypes.Receipt, []*ethtypes.Log, error) {
+	bc.configMu.RLock()
+	processor, validator := bc.processor, bc.validator
+	bc.configMu.RUnlock()
+
 	// Validate that we are about to insert a valid block.
 	// If the block number is greater than 1,
 	// it means it's not the genesis block and needs to be validated. TODO kinda hood.
 	if block.NumberU64() > 1 { // TODO DIAGNOSE
-		if err := bc.validator.ValidateBody(block); err != nil {
+		if err := validator.ValidateBody(block); err != nil {
 			log.Error("invalid block body", "err", err)
+			bc.ReportBadBlock(block, err)
 			return nil, nil, err
 		}
 	}
 
 	// Process the incoming EVM block.
-	receipts, logs, usedGas, err := bc.processor.Process(block, state, *bc.vmConfig)
+	receipts, logs, usedGas, err := processor.Process(block, state, *bc.vmConfig)
 	if err != nil {
 		log.Error("failed to process block", "num", block.NumberU64(), "err", err)
+		bc.ReportBadBlock(block, err)
 		return nil, nil, err
 	}
 
 	// ValidateState validates the statedb post block processing.
-	if err = bc.validator.ValidateState(block, state, receipts, usedGas); err != nil {
+	if err = validator.ValidateState(block, state, receipts, usedGas); err != nil {
 		log.Error("invalid state after processing block", "num", block.NumberU64(), "err", err)
+		bc.ReportBadBlock(block, err)
 		return nil, nil, err
 	}
 
 	return receipts, logs, nil
 }
 
+// ReportBadBlock records a block that failed to be inserted in the bounded bad block store, from
+// which it can be traced with `debug_traceBadBlock`.
+func (bc *blockchain) ReportBadBlock(block *ethtypes.Block, err error) {
+	rawdb.WriteBadBlock(bc.badBlocks, block)
+	bc.logger.Error(
+		"bad block", "num", block.NumberU64(), "hash", block.Hash().Hex(), "err", err,
+	)
+}
+
 // InsertBlockAndSetHeadWithContext inserts the genesis block
 // into the blockchain using the given context for receipts and logs.
 // It also sets the head of the blockchain to the given block.
@@ -224,14 +245,13 @@ func (bc *blockchain) writeBlockWithState(
 	}
 
 	// Irrelevant of the canonical status, write the block itself to the database.
-	// TODO THIS NEEDS TO WRITE TO EXTERNAL DB.
 	if err = bc.writeHistoricalData(block, receipts); err != nil {
 		return err
 	}
 
 	// Commit all cached state changes into underlying memory database.
 	// In Polaris this is a no-op.
-	_, err = state.Commit(block.NumberU64(), bc.config.IsEIP158(block.Number()))
+	_, err = state.Commit(block.NumberU64(), bc.Config().IsEIP158(block.Number()))
 	if err != nil {
 		return err
 	}
@@ -242,8 +262,9 @@ func (bc *blockchain) writeBlockWithState(
 	return nil
 }
 
-// InsertBlock inserts a block into the canonical chain and updates the state of the blockchain.
-// TODO: WRITE TO EXTERNAL STORE
+// writeHistoricalData stores the block, receipts and txs with the historical plugin, which may
+// be backed by the host chain store or by a node-local database, and prunes the blocks that are
+// out of the retention window.
 func (bc *blockchain) writeHistoricalData(
 	block *ethtypes.Block,
 	receipts ethtypes.Receipts,
@@ -265,6 +286,13 @@ func (bc *blockchain) writeHistoricalData(
 			bc.logger.Error("failed to store transactions", "err", err)
 			return err
 		}
+		if pruneNum, ok := bc.retention.PruneTarget(blockNum); ok {
+			if err = bc.hp.PruneBlocks(pruneNum, bc.retention.KeepReceipts()); err != nil {
+				bc.logger.Error("failed to prune blocks", "num", pruneNum, "err", err)
+				return err
+			}
+			bc.evictPruned(pruneNum)
+		}
 	}
 
 	return nil
@@ -27,6 +27,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
 )
 
 type (
@@ -35,6 +36,12 @@ type (
 	GenesisAccount = core.GenesisAccount
 )
 
+// BeaconRootsCode is the runtime bytecode of the EIP-4788 beacon roots system contract, which is
+// predeployed at params.BeaconRootsStorageAddress.
+var BeaconRootsCode = hexutil.MustDecode("0x3373fffffffffffffffffffffffffffffffffffffffe14604d5760" +
+	"2036146024575f5ffd5b5f35801560495762001fff810690815414603c575f5ffd5b62001fff01545f5260205f" +
+	"f35b5f5ffd5b62001fff42064281555f359062001fff015500")
+
 // DefaultGenesis is the default genesis block used by Polaris.
 var DefaultGenesis = &core.Genesis{
 	// Genesis Block
@@ -53,6 +60,12 @@ var DefaultGenesis = &core.Genesis{
 		common.HexToAddress("0x20f33CE90A13a4b5E7697E3544c3083B8F8A51D4"): {
 			Balance: big.NewInt(0).Mul(big.NewInt(5e18), big.NewInt(100)), //nolint:gomnd // its okay.
 		},
+		// EIP-4788 beacon roots contract.
+		params.BeaconRootsStorageAddress: {
+			Code:    BeaconRootsCode,
+			Nonce:   1,
+			Balance: big.NewInt(0),
+		},
 	},
 
 	// These fields are used for consensus tests. Please don't use them
@@ -82,4 +95,6 @@ func UnmarshalGenesisHeader(header *ethtypes.Header, gen *Genesis) {
 	gen.Mixhash = header.MixDigest
 	gen.Coinbase = header.Coinbase
 	gen.Number = header.Number.Uint64()
+	gen.ExcessBlobGas = header.ExcessBlobGas
+	gen.BlobGasUsed = header.BlobGasUsed
 }
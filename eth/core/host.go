@@ -103,6 +103,9 @@ type (
 		GetBlockByNumber(uint64) (*ethtypes.Block, error)
 		// GetBlockByHash returns the block at the given block hash.
 		GetBlockByHash(common.Hash) (*ethtypes.Block, error)
+		// GetHeaderByHash returns the header at the given block hash, which may outlive the
+		// block body if receipts are kept for pruned blocks.
+		GetHeaderByHash(common.Hash) (*ethtypes.Header, error)
 		// GetTransactionByHash returns the transaction lookup entry at the given transaction
 		// hash.
 		GetTransactionByHash(common.Hash) (*types.TxLookupEntry, error)
@@ -114,6 +117,11 @@ type (
 		StoreReceipts(common.Hash, ethtypes.Receipts) error
 		// StoreTransactions stores the transactions for the given block hash.
 		StoreTransactions(uint64, common.Hash, ethtypes.Transactions) error
+		// PruneBlocks deletes the blocks up to the given block number that have not been pruned
+		// yet, along with their receipts and tx lookup entries unless they are to be kept. It may
+		// prune a limited number of blocks at a time. Lookups of pruned data return
+		// `ErrBlockPruned`.
+		PruneBlocks(uint64, bool) error
 	}
 
 	// PrecompilePlugin defines the methods that the chain running Polaris EVM should implement
@@ -28,6 +28,9 @@ var _ core.HistoricalPlugin = &HistoricalPluginMock{}
 //			GetBlockByNumberFunc: func(v uint64) (*ethtypes.Block, error) {
 //				panic("mock out the GetBlockByNumber method")
 //			},
+//			GetHeaderByHashFunc: func(hash common.Hash) (*ethtypes.Header, error) {
+//				panic("mock out the GetHeaderByHash method")
+//			},
 //			GetReceiptsByHashFunc: func(hash common.Hash) (ethtypes.Receipts, error) {
 //				panic("mock out the GetReceiptsByHash method")
 //			},
@@ -37,6 +40,9 @@ var _ core.HistoricalPlugin = &HistoricalPluginMock{}
 //			PrepareFunc: func(contextMoqParam context.Context)  {
 //				panic("mock out the Prepare method")
 //			},
+//			PruneBlocksFunc: func(v uint64, b bool) error {
+//				panic("mock out the PruneBlocks method")
+//			},
 //			StoreBlockFunc: func(block *ethtypes.Block) error {
 //				panic("mock out the StoreBlock method")
 //			},
@@ -59,6 +65,9 @@ type HistoricalPluginMock struct {
 	// GetBlockByNumberFunc mocks the GetBlockByNumber method.
 	GetBlockByNumberFunc func(v uint64) (*ethtypes.Block, error)
 
+	// GetHeaderByHashFunc mocks the GetHeaderByHash method.
+	GetHeaderByHashFunc func(hash common.Hash) (*ethtypes.Header, error)
+
 	// GetReceiptsByHashFunc mocks the GetReceiptsByHash method.
 	GetReceiptsByHashFunc func(hash common.Hash) (ethtypes.Receipts, error)
 
@@ -68,6 +77,9 @@ type HistoricalPluginMock struct {
 	// PrepareFunc mocks the Prepare method.
 	PrepareFunc func(contextMoqParam context.Context)
 
+	// PruneBlocksFunc mocks the PruneBlocks method.
+	PruneBlocksFunc func(v uint64, b bool) error
+
 	// StoreBlockFunc mocks the StoreBlock method.
 	StoreBlockFunc func(block *ethtypes.Block) error
 
@@ -89,6 +101,11 @@ type HistoricalPluginMock struct {
 			// V is the v argument value.
 			V uint64
 		}
+		// GetHeaderByHash holds details about calls to the GetHeaderByHash method.
+		GetHeaderByHash []struct {
+			// Hash is the hash argument value.
+			Hash common.Hash
+		}
 		// GetReceiptsByHash holds details about calls to the GetReceiptsByHash method.
 		GetReceiptsByHash []struct {
 			// Hash is the hash argument value.
@@ -104,6 +121,13 @@ type HistoricalPluginMock struct {
 			// ContextMoqParam is the contextMoqParam argument value.
 			ContextMoqParam context.Context
 		}
+		// PruneBlocks holds details about calls to the PruneBlocks method.
+		PruneBlocks []struct {
+			// V is the v argument value.
+			V uint64
+			// B is the b argument value.
+			B bool
+		}
 		// StoreBlock holds details about calls to the StoreBlock method.
 		StoreBlock []struct {
 			// Block is the block argument value.
@@ -128,9 +152,11 @@ type HistoricalPluginMock struct {
 	}
 	lockGetBlockByHash       sync.RWMutex
 	lockGetBlockByNumber     sync.RWMutex
+	lockGetHeaderByHash      sync.RWMutex
 	lockGetReceiptsByHash    sync.RWMutex
 	lockGetTransactionByHash sync.RWMutex
 	lockPrepare              sync.RWMutex
+	lockPruneBlocks          sync.RWMutex
 	lockStoreBlock           sync.RWMutex
 	lockStoreReceipts        sync.RWMutex
 	lockStoreTransactions    sync.RWMutex
@@ -200,6 +226,38 @@ func (mock *HistoricalPluginMock) GetBlockByNumberCalls() []struct {
 	return calls
 }
 
+// GetHeaderByHash calls GetHeaderByHashFunc.
+func (mock *HistoricalPluginMock) GetHeaderByHash(hash common.Hash) (*ethtypes.Header, error) {
+	if mock.GetHeaderByHashFunc == nil {
+		panic("HistoricalPluginMock.GetHeaderByHashFunc: method is nil but HistoricalPlugin.GetHeaderByHash was just called")
+	}
+	callInfo := struct {
+		Hash common.Hash
+	}{
+		Hash: hash,
+	}
+	mock.lockGetHeaderByHash.Lock()
+	mock.calls.GetHeaderByHash = append(mock.calls.GetHeaderByHash, callInfo)
+	mock.lockGetHeaderByHash.Unlock()
+	return mock.GetHeaderByHashFunc(hash)
+}
+
+// GetHeaderByHashCalls gets all the calls that were made to GetHeaderByHash.
+// Check the length with:
+//
+//	len(mockedHistoricalPlugin.GetHeaderByHashCalls())
+func (mock *HistoricalPluginMock) GetHeaderByHashCalls() []struct {
+	Hash common.Hash
+} {
+	var calls []struct {
+		Hash common.Hash
+	}
+	mock.lockGetHeaderByHash.RLock()
+	calls = mock.calls.GetHeaderByHash
+	mock.lockGetHeaderByHash.RUnlock()
+	return calls
+}
+
 // GetReceiptsByHash calls GetReceiptsByHashFunc.
 func (mock *HistoricalPluginMock) GetReceiptsByHash(hash common.Hash) (ethtypes.Receipts, error) {
 	if mock.GetReceiptsByHashFunc == nil {
@@ -296,6 +354,42 @@ func (mock *HistoricalPluginMock) PrepareCalls() []struct {
 	return calls
 }
 
+// PruneBlocks calls PruneBlocksFunc.
+func (mock *HistoricalPluginMock) PruneBlocks(v uint64, b bool) error {
+	if mock.PruneBlocksFunc == nil {
+		panic("HistoricalPluginMock.PruneBlocksFunc: method is nil but HistoricalPlugin.PruneBlocks was just called")
+	}
+	callInfo := struct {
+		V uint64
+		B bool
+	}{
+		V: v,
+		B: b,
+	}
+	mock.lockPruneBlocks.Lock()
+	mock.calls.PruneBlocks = append(mock.calls.PruneBlocks, callInfo)
+	mock.lockPruneBlocks.Unlock()
+	return mock.PruneBlocksFunc(v, b)
+}
+
+// PruneBlocksCalls gets all the calls that were made to PruneBlocks.
+// Check the length with:
+//
+//	len(mockedHistoricalPlugin.PruneBlocksCalls())
+func (mock *HistoricalPluginMock) PruneBlocksCalls() []struct {
+	V uint64
+	B bool
+} {
+	var calls []struct {
+		V uint64
+		B bool
+	}
+	mock.lockPruneBlocks.RLock()
+	calls = mock.calls.PruneBlocks
+	mock.lockPruneBlocks.RUnlock()
+	return calls
+}
+
 // StoreBlock calls StoreBlockFunc.
 func (mock *HistoricalPluginMock) StoreBlock(block *ethtypes.Block) error {
 	if mock.StoreBlockFunc == nil {
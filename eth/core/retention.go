@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import "fmt"
+
+// Retention modes of the historical blocks, receipts and tx lookup entries of the chain.
+const (
+	// RetentionArchive keeps the historical data of every block.
+	RetentionArchive = "archive"
+	// RetentionRecent keeps the historical data of the most recent blocks only.
+	RetentionRecent = "recent"
+	// RetentionReceipts keeps the receipts and tx lookup entries of every block, but only the
+	// bodies of the most recent blocks.
+	RetentionReceipts = "receipts"
+)
+
+// RetentionPolicy defines which historical data is kept by the chain.
+type RetentionPolicy struct {
+	// Mode is the retention mode, an empty mode is equivalent to `RetentionArchive`.
+	Mode string
+
+	// Blocks is the number of most recent blocks that are fully retained, unused in the archive
+	// mode.
+	Blocks uint64
+}
+
+// Validate returns an error if the retention policy is invalid.
+func (p RetentionPolicy) Validate() error {
+	switch p.Mode {
+	case "", RetentionArchive:
+		return nil
+	case RetentionRecent, RetentionReceipts:
+		if p.Blocks == 0 {
+			return fmt.Errorf("retention mode %q requires a positive number of blocks", p.Mode)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown retention mode %q", p.Mode)
+	}
+}
+
+// IsArchive returns whether the policy keeps the historical data of every block.
+func (p RetentionPolicy) IsArchive() bool {
+	return p.Mode == "" || p.Mode == RetentionArchive
+}
+
+// PruneTarget returns the number of the highest block that is out of the retention window once
+// the block with the given number is written, if any. The genesis block is never pruned.
+func (p RetentionPolicy) PruneTarget(number uint64) (uint64, bool) {
+	if p.Mode != RetentionRecent && p.Mode != RetentionReceipts {
+		return 0, false
+	}
+	if number <= p.Blocks {
+		return 0, false
+	}
+	return number - p.Blocks, true
+}
+
+// KeepReceipts returns whether receipts and tx lookup entries outlive pruned blocks.
+func (p RetentionPolicy) KeepReceipts() bool {
+	return p.Mode == RetentionReceipts
+}
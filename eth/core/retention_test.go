@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"github.com/berachain/polaris/eth/core"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RetentionPolicy", func() {
+	It("should validate the mode and number of blocks", func() {
+		Expect(core.RetentionPolicy{}.Validate()).To(Succeed())
+		Expect(core.RetentionPolicy{Mode: core.RetentionArchive}.Validate()).To(Succeed())
+		Expect(core.RetentionPolicy{Mode: core.RetentionRecent, Blocks: 1}.Validate()).
+			To(Succeed())
+		Expect(core.RetentionPolicy{Mode: core.RetentionReceipts}.Validate()).ToNot(Succeed())
+		Expect(core.RetentionPolicy{Mode: "full", Blocks: 1}.Validate()).ToNot(Succeed())
+	})
+
+	It("should only be an archive in the archive mode", func() {
+		Expect(core.RetentionPolicy{}.IsArchive()).To(BeTrue())
+		Expect(core.RetentionPolicy{Mode: core.RetentionArchive}.IsArchive()).To(BeTrue())
+		Expect(core.RetentionPolicy{Mode: core.RetentionRecent, Blocks: 1}.IsArchive()).
+			To(BeFalse())
+	})
+
+	It("should never prune in the archive mode", func() {
+		_, ok := core.RetentionPolicy{Mode: core.RetentionArchive, Blocks: 1}.PruneTarget(100)
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should prune the block that falls out of the window", func() {
+		policy := core.RetentionPolicy{Mode: core.RetentionRecent, Blocks: 10}
+		_, ok := policy.PruneTarget(10)
+		Expect(ok).To(BeFalse())
+		number, ok := policy.PruneTarget(11)
+		Expect(ok).To(BeTrue())
+		Expect(number).To(Equal(uint64(1)))
+		Expect(policy.KeepReceipts()).To(BeFalse())
+		Expect(core.RetentionPolicy{Mode: core.RetentionReceipts}.KeepReceipts()).To(BeTrue())
+	})
+})
@@ -36,8 +36,9 @@ func DeriveReceiptsFromBlock(
 ) (ethtypes.Receipts, error) {
 	// calculate the blobGasPrice according to the excess blob gas.
 	var blobGasPrice = new(big.Int)
-	if chainConfig.IsCancun(block.Number(), block.Time()) {
-		blobGasPrice = eip4844.CalcBlobFee(*block.ExcessBlobGas())
+	if excessBlobGas := block.ExcessBlobGas(); excessBlobGas != nil &&
+		chainConfig.IsCancun(block.Number(), block.Time()) {
+		blobGasPrice = eip4844.CalcBlobFee(*excessBlobGas)
 	}
 
 	// Derive receipts from block.
@@ -21,8 +21,12 @@
 package eth
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
+	"time"
 
 	"github.com/berachain/polaris/eth/consensus"
 	pcore "github.com/berachain/polaris/eth/core"
@@ -39,12 +43,16 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 )
 
+// healthReadHeaderTimeout bounds the time to read the headers of requests to the health endpoints.
+const healthReadHeaderTimeout = 5 * time.Second
+
 type (
 
 	// Miner represents the `Miner` that exists on the backend of the execution layer.
 	Miner interface {
 		BuildPayload(*miner.BuildPayloadArgs) (*miner.Payload, error)
 		Etherbase() common.Address
+		SetGasCeil(ceil uint64)
 	}
 
 	// TxPool represents the `TxPool` that exists on the backend of the execution layer.
@@ -54,6 +62,7 @@ type (
 		SubscribeTransactions(ch chan<- core.NewTxsEvent, reorgs bool) event.Subscription
 		Status(hash common.Hash) txpool.TxStatus
 		Has(hash common.Hash) bool
+		Get(hash common.Hash) *ethtypes.Transaction
 	}
 
 	// NetworkingStack is the entrypoint for the evm execution environment.
@@ -83,6 +92,11 @@ type (
 		stack NetworkingStack
 		// backend is the entry point to the core logic of the execution layer.
 		backend *polar.Polaris
+		// readiness gates serving JSON-RPC and reports the health of the execution layer.
+		readiness *Readiness
+		// health serves the health and readiness endpoints on healthAddr.
+		health     *http.Server
+		healthAddr string
 	}
 
 	// Config struct holds the configuration for Polaris and Node.
@@ -140,21 +154,67 @@ func newGethExecutionLayer(
 
 	// Return a new ExecutionLayer with the created gethNode and backend
 	return &ExecutionLayer{
-		stack:   gethNode,
-		backend: backend,
+		stack:      gethNode,
+		backend:    backend,
+		readiness:  NewReadiness(),
+		healthAddr: cfg.Node.HealthAddr,
 	}, nil
 }
 
+// ServeHealth serves the health and readiness endpoints on the health address in the background,
+// unless it is empty. Unlike JSON-RPC, they are served from startup, so that they report the
+// execution layer as unavailable until it is ready.
+func (el *ExecutionLayer) ServeHealth() error {
+	if el.healthAddr == "" {
+		return nil
+	}
+	ln, err := net.Listen("tcp", el.healthAddr)
+	if err != nil {
+		return err
+	}
+
+	el.health = &http.Server{
+		Handler:           el.readiness.Handler(),
+		ReadHeaderTimeout: healthReadHeaderTimeout,
+	}
+	go func() {
+		if serveErr := el.health.Serve(ln); !errors.Is(serveErr, http.ErrServerClosed) {
+			log.Error("failed to serve health endpoints", "err", serveErr)
+		}
+	}()
+	return nil
+}
+
 // Start starts the networking stack of the execution layer.
 // It returns an error if the start operation fails.
 func (el *ExecutionLayer) Start() error {
-	return el.stack.Start()
+	if err := el.stack.Start(); err != nil {
+		el.readiness.SetError(err)
+		return err
+	}
+	el.readiness.SetServing(true)
+	return nil
 }
 
-// Close stops the networking stack of the execution layer.
+// StartWhenReady blocks until the readiness conditions of the execution layer are met, and then
+// starts its networking stack. It returns an error if the context is done first or the start
+// operation fails.
+func (el *ExecutionLayer) StartWhenReady(ctx context.Context) error {
+	if err := el.readiness.Wait(ctx); err != nil {
+		return err
+	}
+	return el.Start()
+}
+
+// Close stops the networking stack and the health endpoints of the execution layer.
 // It returns an error if the close operation fails.
 func (el *ExecutionLayer) Close() error {
-	return el.stack.Close()
+	el.readiness.SetServing(false)
+	err := el.stack.Close()
+	if el.health != nil {
+		err = errors.Join(err, el.health.Close())
+	}
+	return err
 }
 
 // Backend returns the Polaris backend associated with the execution layer.
@@ -162,6 +222,17 @@ func (el *ExecutionLayer) Backend() *polar.Polaris {
 	return el.backend
 }
 
+// Miner returns the miner of the execution layer. The miner is rebuilt when the chain config
+// changes, so it should not be held.
+func (el *ExecutionLayer) Miner() Miner {
+	return el.backend.Miner()
+}
+
+// Readiness returns the readiness conditions of the execution layer.
+func (el *ExecutionLayer) Readiness() *Readiness {
+	return el.readiness
+}
+
 // Stack returns the NetworkingStack associated with the execution layer.
 func (el *ExecutionLayer) Stack() NetworkingStack {
 	return el.stack
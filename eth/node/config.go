@@ -27,12 +27,19 @@ import (
 const (
 	// clientIdentifier is the identifier string for the client.
 	clientIdentifier = "polaris-geth"
+	// defaultHealthAddr is the default address of the health and readiness endpoints.
+	defaultHealthAddr = "0.0.0.0:8548"
 )
 
 // Config represents the configuration options for a node running a polar
 // evm.
 type Config struct {
 	node.Config
+
+	// HealthAddr is the address that the health and readiness endpoints are served on from
+	// startup, by a listener of their own rather than the networking stack. They are not served
+	// if it is empty.
+	HealthAddr string
 }
 
 // DefaultConfig returns the default configuration for a polaris chain.
@@ -41,6 +48,7 @@ func DefaultConfig() *Config {
 	nodeCfg.DataDir = ""
 	nodeCfg.KeyStoreDir = ""
 	return &Config{
-		Config: nodeCfg,
+		Config:     nodeCfg,
+		HealthAddr: defaultHealthAddr,
 	}
 }
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polarapi
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethapi"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// DebugBackend is the collection of methods required to satisfy the debug
+// RPC API.
+type DebugBackend interface {
+	BadBlocks() []*ethtypes.Block
+	ChainConfig() *params.ChainConfig
+}
+
+// BadBlockArgs represents the entries in the list returned when bad blocks are queried.
+type BadBlockArgs struct {
+	Hash  common.Hash    `json:"hash"`
+	Block map[string]any `json:"block"`
+	RLP   string         `json:"rlp"`
+}
+
+// DebugAPI is the collection of debug RPC API methods that are not served by the tracers.
+type DebugAPI interface {
+	GetBadBlocks() ([]*BadBlockArgs, error)
+}
+
+// debugAPI offers debugging related RPC methods.
+type debugAPI struct {
+	b DebugBackend
+}
+
+// NewDebugAPI creates a new debug API instance.
+func NewDebugAPI(b DebugBackend) DebugAPI {
+	return &debugAPI{b}
+}
+
+// GetBadBlocks returns the most recent blocks that were rejected by this node, which can be
+// traced with `debug_traceBadBlock`.
+func (api *debugAPI) GetBadBlocks() ([]*BadBlockArgs, error) {
+	blocks := api.b.BadBlocks()
+	results := make([]*BadBlockArgs, 0, len(blocks))
+	for _, block := range blocks {
+		blockRlp, err := rlp.EncodeToBytes(block)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &BadBlockArgs{
+			Hash:  block.Hash(),
+			Block: ethapi.RPCMarshalBlock(block, true, true, api.b.ChainConfig()),
+			RLP:   fmt.Sprintf("%#x", blockRlp),
+		})
+	}
+	return results, nil
+}
@@ -59,6 +59,7 @@ type (
 		ethapi.Backend
 		polarapi.NetBackend
 		polarapi.Web3Backend
+		polarapi.DebugBackend
 		tracers.Backend
 	}
 
@@ -150,9 +151,15 @@ func (b *backend) FeeHistory(
 	return b.gpo.FeeHistory(ctx, blockCount, lastBlock, rewardPercentiles)
 }
 
-// ChainDb is unused in Polaris.
+// ChainDb returns the bad block store of the chain, which is the only part of a geth chain
+// database that is used in Polaris. It allows the tracers to trace bad blocks.
 func (b *backend) ChainDb() ethdb.Database { //nolint:stylecheck // conforms to interface.
-	return ethdb.Database(nil)
+	return b.polar.blockchain.BadBlockDB()
+}
+
+// BadBlocks returns the most recent blocks that were rejected by this node.
+func (b *backend) BadBlocks() []*ethtypes.Block {
+	return b.polar.blockchain.BadBlocks()
 }
 
 // AccountManager is unused in Polaris.
@@ -204,7 +211,7 @@ func (b *backend) HeaderByNumber(
 	switch number {
 	case rpc.PendingBlockNumber:
 		// TODO: handle "miner" stuff, Pending block is only known by the miner
-		block := b.polar.miner.PendingBlock()
+		block := b.polar.Miner().PendingBlock()
 		if block == nil {
 			return nil, nil //nolint:nilnil // it's ok.
 		}
@@ -256,7 +263,7 @@ func (b *backend) BlockByNumber(
 	// Pending block is only known by the miner
 	switch number {
 	case rpc.PendingBlockNumber:
-		block := b.polar.miner.PendingBlock()
+		block := b.polar.Miner().PendingBlock()
 		return block, nil
 	// Otherwise resolve and return the block
 	case rpc.LatestBlockNumber:
@@ -275,7 +282,11 @@ func (b *backend) BlockByNumber(
 		return b.polar.blockchain.GetBlockByNumber(0), nil
 	}
 	// safe to assume number > 0
-	return b.polar.blockchain.GetBlockByNumber(uint64(number)), nil
+	block := b.polar.blockchain.GetBlockByNumber(uint64(number))
+	if block == nil && b.polar.blockchain.HistoryPruned(common.Hash{}, uint64(number)) {
+		return nil, pcore.ErrBlockPruned
+	}
+	return block, nil
 }
 
 // BlockByHash returns the block with the given `hash`.
@@ -283,6 +294,9 @@ func (b *backend) BlockByHash(_ context.Context, hash common.Hash) (*ethtypes.Bl
 	block := b.polar.blockchain.GetBlockByHash(hash)
 	b.logger.Debug("BlockByHash", "hash", hash, "block", block)
 	if block == nil {
+		if b.polar.blockchain.HistoryPruned(hash, 0) {
+			return nil, pcore.ErrBlockPruned
+		}
 		b.logger.Error("eth.rpc.backend.BlockByHash", "hash", hash, "nil", true)
 		return nil, nil //nolint:nilnil // to match geth.
 	}
@@ -322,7 +336,7 @@ func (b *backend) StateAndHeaderByNumber(
 ) (state.StateDB, *ethtypes.Header, error) {
 	// Pending state is only known by the miner
 	if number == rpc.PendingBlockNumber {
-		block, state := b.polar.miner.Pending()
+		block, state := b.polar.Miner().Pending()
 		if block == nil {
 			return nil, nil, nil
 		}
@@ -408,7 +422,7 @@ func (b *backend) GetTransaction(
 // PendingBlockAndReceipts returns the pending block (equivalent to current block in Polaris)
 // and associated receipts.
 func (b *backend) PendingBlockAndReceipts() (*ethtypes.Block, ethtypes.Receipts) {
-	block, receipts := b.polar.miner.PendingBlockAndReceipts()
+	block, receipts := b.polar.Miner().PendingBlockAndReceipts()
 	// If the block is non-existent, return nil.
 	// This is to maintain parity with the behavior of the geth backend.
 	if block == nil {
@@ -422,7 +436,11 @@ func (b *backend) PendingBlockAndReceipts() (*ethtypes.Block, ethtypes.Receipts)
 
 // GetReceipts returns the receipts for the given block hash.
 func (b *backend) GetReceipts(_ context.Context, hash common.Hash) (ethtypes.Receipts, error) {
-	return b.polar.blockchain.GetReceiptsByHash(hash), nil
+	receipts := b.polar.blockchain.GetReceiptsByHash(hash)
+	if receipts == nil && b.polar.blockchain.HistoryPruned(hash, 0) {
+		return nil, pcore.ErrBlockPruned
+	}
+	return receipts, nil
 }
 
 // GetLogs returns the logs for the given block hash or number.
@@ -590,16 +608,18 @@ func (b *backend) SubscribeLogsEvent(ch chan<- []*ethtypes.Log) event.Subscripti
 }
 
 func (b *backend) SubscribePendingLogsEvent(ch chan<- []*ethtypes.Log) event.Subscription {
-	return b.polar.miner.SubscribePendingLogs(ch)
+	return b.polar.SubscribePendingLogs(ch)
 }
 
+// BloomStatus returns the number of blocks per bloombits section and the number of indexed
+// sections.
 func (b *backend) BloomStatus() (uint64, uint64) {
-	// TODO: Implement your code here
-	return 0, 0
+	return b.polar.bloomIndexer.BloomStatus()
 }
 
-func (b *backend) ServiceFilter(_ context.Context, _ *bloombits.MatcherSession) {
-	// TODO: Implement your code here
+// ServiceFilter serves the bloombits retrievals of the given matcher session.
+func (b *backend) ServiceFilter(ctx context.Context, session *bloombits.MatcherSession) {
+	b.polar.bloomIndexer.ServiceFilter(ctx, session)
 }
 
 // Version returns the current chain protocol version.
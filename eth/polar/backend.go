@@ -22,6 +22,8 @@ package polar
 
 import (
 	"math/big"
+	"path/filepath"
+	"sync"
 
 	"github.com/berachain/polaris/eth/consensus"
 	"github.com/berachain/polaris/eth/core"
@@ -30,7 +32,9 @@ import (
 	"github.com/ethereum/go-ethereum/cmd/utils"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus/beacon"
+	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/txpool/blobpool"
 	"github.com/ethereum/go-ethereum/core/txpool/legacypool"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/ethconfig"
@@ -41,6 +45,7 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/miner"
 	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 
 	// To ensure that tracer engines get loaded in.
@@ -52,6 +57,20 @@ import (
 // abstracted away networking stack, by extension we will need to improve the registration
 // architecture.
 
+const (
+	// bloomBitsDBName is the name of the bloombits database in the bloombits directory.
+	bloomBitsDBName = "bloombits"
+	// bloomBitsDBNamespace is the metrics namespace of the bloombits database.
+	bloomBitsDBNamespace = "polaris/db/bloombits/"
+	// bloomBitsDBCache is the memory (in MB) allotted to the bloombits database cache.
+	bloomBitsDBCache = 16
+	// bloomBitsDBHandles is the number of file handles allotted to the bloombits database.
+	bloomBitsDBHandles = 16
+	// pendingLogsChanSize is the size of the channel that the pending logs of the miner are
+	// forwarded through.
+	pendingLogsChanSize = 10
+)
+
 var defaultEthConfig = ethconfig.Config{
 	SyncMode:           0,
 	FilterLogCacheSize: 0,
@@ -72,7 +91,20 @@ type Polaris struct {
 	host       core.PolarisHostChain
 	blockchain core.Blockchain
 	txPool     *txpool.TxPool
-	miner      *miner.Miner
+
+	// minerMu guards the miner, which is rebuilt when the chain config of the blockchain
+	// changes, as the geth miner holds the chain config that it is built with.
+	minerMu          sync.Mutex
+	miner            *miner.Miner
+	minerChainConfig *params.ChainConfig
+	// minerLogsSub forwards the pending logs of the miner to the pending logs feed, so that
+	// subscriptions to pending logs outlive the miner.
+	minerLogsSub    event.Subscription
+	pendingLogsFeed event.Feed
+	eventMux        *event.TypeMux //nolint:staticcheck // deprecated but still in geth.
+
+	// bloomIndexer indexes the header blooms of the chain for log filtering.
+	bloomIndexer *core.BloomIndexer
 
 	// apiBackend is utilize by the api handlers as a middleware between the
 	// JSON-RPC APIs and the core pieces.
@@ -110,7 +142,22 @@ func New(
 		host:       host,
 		engine:     engine,
 		blockchain: core.NewChain(host, &config.Chain, engine),
+		eventMux:   stack.EventMux(),
 	}
+	pl.blockchain.SetRetentionPolicy(config.Historical.Retention)
+
+	// Setup the bloombits indexer, which is kept in memory if no directory is configured.
+	bloomDB := rawdb.NewMemoryDatabase()
+	if config.BloomBits.Datadir != "" {
+		var err error
+		if bloomDB, err = rawdb.NewLevelDBDatabase(
+			filepath.Join(config.BloomBits.Datadir, bloomBitsDBName),
+			bloomBitsDBCache, bloomBitsDBHandles, bloomBitsDBNamespace, false,
+		); err != nil {
+			panic(err)
+		}
+	}
+	pl.bloomIndexer = core.NewBloomIndexer(bloomDB)
 
 	// Build the backend api object.
 	pl.apiBackend = NewAPIBackend(
@@ -121,27 +168,24 @@ func New(
 	// with development configs.
 	pl.config.SafetyMessage()
 
-	// For now, we only have a legacy pool, we will implement blob pool later.
+	// Setup the legacy and blob (EIP-4844) subpools.
 	legacyPool := legacypool.New(
 		pl.config.LegacyTxPool, pl.Blockchain(),
 	)
+	blobPool := blobpool.New(
+		pl.config.BlobPool, pl.Blockchain(),
+	)
 
-	// Setup the transaction pool and attach the legacyPool.
+	// Setup the transaction pool and attach the subpools.
 	var err error
 	if pl.txPool, err = txpool.New(
 		new(big.Int).SetUint64(pl.config.LegacyTxPool.PriceLimit),
 		pl.blockchain,
-		[]txpool.SubPool{legacyPool},
+		[]txpool.SubPool{legacyPool, blobPool},
 	); err != nil {
 		panic(err)
 	}
 
-	// Setup the miner, we use a dummy isLocal function, since it is not used.
-	pl.miner = miner.New(pl, &pl.config.Miner,
-		&pl.config.Chain, stack.EventMux(), pl.engine,
-		func(header *ethtypes.Header) bool { return true },
-	)
-
 	// Register the backend on the node
 	stack.RegisterAPIs(pl.APIs())
 	stack.RegisterLifecycle(pl)
@@ -154,13 +198,14 @@ func New(
 // Start implements node.Lifecycle, starting all internal goroutines needed by the
 // Polaris protocol implementation.
 func (pl *Polaris) Start() error {
+	pl.bloomIndexer.Start(pl.blockchain)
 	return nil
 }
 
 // Stop implements node.Lifecycle, terminating all internal goroutines used by the
 // Polaris protocol.
 func (pl *Polaris) Stop() error {
-	return nil
+	return pl.bloomIndexer.Close()
 }
 
 // APIs return the collection of RPC services the polar package offers.
@@ -182,11 +227,14 @@ func (pl *Polaris) APIs() []rpc.API {
 			),
 		},
 		{
-			// NOTE: endpoints that require tracing "bad blocks" are currently not supported
-			// (debug_traceBadBlock, debug_intermediateRoots, debug_standardTraceBadBlockToFile)
+			// Bad blocks are served from the bounded bad block store of the chain.
 			Namespace: "debug",
 			Service:   tracers.NewAPI(pl.apiBackend),
 		},
+		{
+			Namespace: "debug",
+			Service:   polarapi.NewDebugAPI(pl.apiBackend),
+		},
 	}...)
 }
 
@@ -205,11 +253,54 @@ func (pl *Polaris) Host() core.PolarisHostChain {
 // Engine returns the consensus engine.
 func (pl *Polaris) Engine() consensus.Engine { return pl.engine }
 
-// Miner returns the miner.
+// Miner returns the miner. It is rebuilt against the chain config of the blockchain when the
+// chain config changes, so it should not be held.
 func (pl *Polaris) Miner() *miner.Miner {
+	pl.minerMu.Lock()
+	defer pl.minerMu.Unlock()
+
+	chainConfig := pl.blockchain.Config()
+	if pl.miner != nil && pl.minerChainConfig == chainConfig {
+		return pl.miner
+	}
+	if pl.miner != nil {
+		pl.minerLogsSub.Unsubscribe()
+		pl.miner.Close()
+	}
+
+	// Setup the miner, we use a dummy isLocal function, since it is not used.
+	pl.miner = miner.New(pl, &pl.config.Miner,
+		chainConfig, pl.eventMux, pl.engine,
+		func(header *ethtypes.Header) bool { return true },
+	)
+	pl.minerChainConfig = chainConfig
+	pl.minerLogsSub = pl.forwardPendingLogs(pl.miner)
 	return pl.miner
 }
 
+// forwardPendingLogs forwards the pending logs of the miner to the pending logs feed until the
+// returned subscription is unsubscribed.
+func (pl *Polaris) forwardPendingLogs(m *miner.Miner) event.Subscription {
+	ch := make(chan []*ethtypes.Log, pendingLogsChanSize)
+	sub := m.SubscribePendingLogs(ch)
+	go func() {
+		for {
+			select {
+			case logs := <-ch:
+				pl.pendingLogsFeed.Send(logs)
+			case <-sub.Err():
+				return
+			}
+		}
+	}()
+	return sub
+}
+
+// SubscribePendingLogs subscribes to the pending logs of the miner.
+func (pl *Polaris) SubscribePendingLogs(ch chan<- []*ethtypes.Log) event.Subscription {
+	return pl.pendingLogsFeed.Subscribe(ch)
+}
+
 // TxPool returns the transaction pool.
 func (pl *Polaris) TxPool() *txpool.TxPool {
 	return pl.txPool
@@ -21,12 +21,15 @@
 package polar
 
 import (
+	"fmt"
 	"math/big"
 	"time"
 
+	"github.com/berachain/polaris/eth/core"
 	"github.com/berachain/polaris/eth/params"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/txpool/blobpool"
 	"github.com/ethereum/go-ethereum/core/txpool/legacypool"
 	"github.com/ethereum/go-ethereum/eth/ethconfig"
 	"github.com/ethereum/go-ethereum/eth/gasprice"
@@ -44,6 +47,11 @@ const (
 	// DO NOT USE IN PRODUCTION.
 	// 0xf8637fa70e8e329ecb8463b788d96914f8cfe191d15ae36f161227629e3f5693.
 	developmentCoinbase = "0xAf15f95bed0D3913a29092Fd7837451Ce4de64D3"
+
+	// defaultMinerBuildTimeout is the default time budget for building a payload. It leaves
+	// room within CometBFT's default timeout_propose of 3s for the miner's new payload timeout
+	// of 2s and for gossiping the proposal.
+	defaultMinerBuildTimeout = 2500 * time.Millisecond
 )
 
 // DefaultConfig returns the default JSON-RPC config.
@@ -54,15 +62,23 @@ func DefaultConfig() *Config {
 	minerCfg.Etherbase = common.HexToAddress(developmentCoinbase)
 	legacyPool := legacypool.DefaultConfig
 	legacyPool.Journal = ""
+	blobPool := blobpool.DefaultConfig
+	blobPool.Datadir = ""
+	historical := HistoricalConfig{
+		Retention: core.RetentionPolicy{Mode: core.RetentionArchive},
+	}
 
 	return &Config{
-		Chain:         *params.DefaultChainConfig,
-		Miner:         minerCfg,
-		GPO:           gpoConfig,
-		LegacyTxPool:  legacyPool,
-		RPCGasCap:     ethconfig.Defaults.RPCGasCap,
-		RPCTxFeeCap:   ethconfig.Defaults.RPCTxFeeCap,
-		RPCEVMTimeout: ethconfig.Defaults.RPCEVMTimeout,
+		Chain:             *params.DefaultChainConfig,
+		Miner:             minerCfg,
+		MinerBuildTimeout: defaultMinerBuildTimeout,
+		GPO:               gpoConfig,
+		LegacyTxPool:      legacyPool,
+		BlobPool:          blobPool,
+		Historical:        historical,
+		RPCGasCap:         ethconfig.Defaults.RPCGasCap,
+		RPCTxFeeCap:       ethconfig.Defaults.RPCTxFeeCap,
+		RPCEVMTimeout:     ethconfig.Defaults.RPCEVMTimeout,
 	}
 }
 
@@ -84,12 +100,29 @@ type Config struct {
 	// Mining options
 	Miner miner.Config
 
+	// MinerBuildTimeout is the time budget for building a payload when preparing a proposal.
+	// The full payload is proposed once it is built, or the best payload built so far at the end
+	// of the budget. Zero waits for the full payload.
+	MinerBuildTimeout time.Duration
+
 	// Gas Price Oracle config.
 	GPO gasprice.Config
 
 	// Transaction pool options
 	LegacyTxPool legacypool.Config
 
+	// Blob transaction pool options
+	BlobPool blobpool.Config
+
+	// Historical data storage options
+	Historical HistoricalConfig
+
+	// Bloombits log index options
+	BloomBits BloomBitsConfig
+
+	// Genesis alloc export and import options
+	Genesis GenesisConfig
+
 	// RPCGasCap is the global gas cap for eth-call variants.
 	RPCGasCap uint64
 
@@ -100,3 +133,51 @@ type Config struct {
 	// send-transaction variants. The unit is ether.
 	RPCTxFeeCap float64
 }
+
+// HistoricalConfig configures where historical blocks, receipts and tx lookups are stored.
+type HistoricalConfig struct {
+	// Backend is the database backend (e.g. "goleveldb" or "pebbledb") of a node-local store
+	// for historical data. If empty, historical data is kept in the host chain's store.
+	Backend string
+
+	// Datadir is the directory of the node-local historical data store.
+	Datadir string
+
+	// Retention is the policy that historical data is pruned by. Historical data that is kept in
+	// the host chain's store is part of its consensus state, so it can only be pruned if it is
+	// kept in a node-local store.
+	Retention core.RetentionPolicy
+}
+
+// Validate returns an error if the retention policy is invalid, or prunes historical data that
+// is kept in the host chain's store.
+func (c HistoricalConfig) Validate() error {
+	if err := c.Retention.Validate(); err != nil {
+		return err
+	}
+	if c.Backend == "" && !c.Retention.IsArchive() {
+		return fmt.Errorf(
+			"retention mode %q requires a node-local historical backend", c.Retention.Mode,
+		)
+	}
+	return nil
+}
+
+// GenesisConfig configures how the genesis alloc is exported and imported in chunks, so that the
+// state of a large chain does not have to be held in memory at once.
+type GenesisConfig struct {
+	// AllocDir is the directory that the chunks of a genesis alloc are exported to and imported
+	// from.
+	AllocDir string
+
+	// AllocChunkSize is the number of accounts in each exported chunk. If zero, the genesis alloc
+	// is exported inline in the genesis file.
+	AllocChunkSize uint64
+}
+
+// BloomBitsConfig configures the bloombits index that speeds up log filtering.
+type BloomBitsConfig struct {
+	// Datadir is the directory of the bloombits database. If empty, the index is kept in memory
+	// and rebuilt from the historical data on restart.
+	Datadir string
+}
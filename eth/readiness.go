@@ -0,0 +1,176 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package eth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+)
+
+// Paths of the endpoints that report the health and readiness of the execution layer.
+const (
+	HealthPath = "/health"
+	ReadyPath  = "/ready"
+)
+
+// Readiness tracks the conditions that have to be met before the execution layer serves
+// JSON-RPC, i.e. the last state of the chain is loaded and a block has been committed on top of
+// it, and reports them on the health and readiness endpoints.
+type Readiness struct {
+	mu sync.Mutex
+
+	stateLoaded    bool
+	blockCommitted bool
+	serving        bool
+	err            error
+
+	// ready is closed once the state is loaded and a block is committed.
+	ready chan struct{}
+}
+
+// readinessStatus is the response body of the health and readiness endpoints.
+type readinessStatus struct {
+	Status         string `json:"status"`
+	StateLoaded    bool   `json:"stateLoaded"`
+	BlockCommitted bool   `json:"blockCommitted"`
+	Serving        bool   `json:"serving"`
+	Error          string `json:"error,omitempty"`
+}
+
+// NewReadiness creates a new Readiness with none of its conditions met.
+func NewReadiness() *Readiness {
+	return &Readiness{ready: make(chan struct{})}
+}
+
+// SetStateLoaded records that the last state of the chain has been loaded.
+func (r *Readiness) SetStateLoaded() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.stateLoaded = true
+	r.signalLocked()
+}
+
+// SetBlockCommitted records that a block has been committed on top of the loaded state.
+func (r *Readiness) SetBlockCommitted() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.blockCommitted = true
+	r.signalLocked()
+}
+
+// SetServing records whether the execution layer is serving JSON-RPC.
+func (r *Readiness) SetServing(serving bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.serving = serving
+}
+
+// SetError records an error that makes the execution layer unhealthy.
+func (r *Readiness) SetError(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.err = err
+}
+
+// Wait blocks until the state is loaded and a block is committed, or the context is done.
+func (r *Readiness) Wait(ctx context.Context) error {
+	select {
+	case <-r.ready:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Healthy returns whether the execution layer is serving without errors.
+func (r *Readiness) Healthy() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.serving && r.err == nil
+}
+
+// Ready returns whether all readiness conditions are met and JSON-RPC is being served.
+func (r *Readiness) Ready() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.stateLoaded && r.blockCommitted && r.serving && r.err == nil
+}
+
+// HealthHandler returns the handler of the health endpoint, which responds with 200 while the
+// execution layer is serving without errors and with 503 otherwise.
+func (r *Readiness) HealthHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		r.respond(w, r.Healthy())
+	})
+}
+
+// ReadyHandler returns the handler of the readiness endpoint, which responds with 200 once all
+// readiness conditions are met and with 503 otherwise.
+func (r *Readiness) ReadyHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		r.respond(w, r.Ready())
+	})
+}
+
+// Handler returns the handler that serves the health and readiness endpoints.
+func (r *Readiness) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle(HealthPath, r.HealthHandler())
+	mux.Handle(ReadyPath, r.ReadyHandler())
+	return mux
+}
+
+// respond writes the current status of the readiness conditions.
+func (r *Readiness) respond(w http.ResponseWriter, ok bool) {
+	r.mu.Lock()
+	status := readinessStatus{
+		Status:         "ok",
+		StateLoaded:    r.stateLoaded,
+		BlockCommitted: r.blockCommitted,
+		Serving:        r.serving,
+	}
+	if r.err != nil {
+		status.Error = r.err.Error()
+	}
+	r.mu.Unlock()
+
+	code := http.StatusOK
+	if !ok {
+		status.Status, code = "unavailable", http.StatusServiceUnavailable
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(status)
+}
+
+// signalLocked releases the waiters once the state is loaded and a block is committed.
+func (r *Readiness) signalLocked() {
+	if !r.stateLoaded || !r.blockCommitted {
+		return
+	}
+	select {
+	case <-r.ready:
+	default:
+		close(r.ready)
+	}
+}
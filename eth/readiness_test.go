@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package eth_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/berachain/polaris/eth"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestEth(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "eth")
+}
+
+var _ = Describe("Readiness", func() {
+	var r *eth.Readiness
+
+	status := func(h http.Handler) int {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, eth.ReadyPath, nil))
+		return rec.Code
+	}
+
+	BeforeEach(func() {
+		r = eth.NewReadiness()
+	})
+
+	It("should wait for the state to be loaded and a block to be committed", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		r.SetBlockCommitted()
+		Expect(r.Wait(ctx)).To(MatchError(context.DeadlineExceeded))
+
+		r.SetStateLoaded()
+		Expect(r.Wait(context.Background())).To(Succeed())
+	})
+
+	It("should serve the health and readiness endpoints", func() {
+		srv := httptest.NewServer(r.Handler())
+		defer srv.Close()
+		r.SetStateLoaded()
+		r.SetBlockCommitted()
+		r.SetServing(true)
+
+		for _, path := range []string{eth.HealthPath, eth.ReadyPath} {
+			resp, err := http.Get(srv.URL + path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Body.Close()).To(Succeed())
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		}
+	})
+
+	It("should only report ready once serving", func() {
+		r.SetStateLoaded()
+		r.SetBlockCommitted()
+		Expect(status(r.ReadyHandler())).To(Equal(http.StatusServiceUnavailable))
+		Expect(status(r.HealthHandler())).To(Equal(http.StatusServiceUnavailable))
+
+		r.SetServing(true)
+		Expect(status(r.ReadyHandler())).To(Equal(http.StatusOK))
+		Expect(status(r.HealthHandler())).To(Equal(http.StatusOK))
+
+		r.SetError(errors.New("boom"))
+		Expect(r.Ready()).To(BeFalse())
+		Expect(status(r.HealthHandler())).To(Equal(http.StatusServiceUnavailable))
+	})
+})